@@ -12,10 +12,12 @@ access the entire buffer's contents with Contents().
 package gbytes
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"regexp"
+	"regexp/syntax"
 	"sync"
 	"time"
 )
@@ -30,6 +32,7 @@ type Buffer struct {
 	readCursor   uint64
 	lock         *sync.Mutex
 	detectCloser chan interface{}
+	watchers     []*watcher
 	closed       bool
 }
 
@@ -81,6 +84,9 @@ func (b *Buffer) Write(p []byte) (n int, err error) {
 	}
 
 	b.contents = append(b.contents, p...)
+	for _, w := range b.watchers {
+		w.scan(b.contents)
+	}
 	return len(p), nil
 }
 
@@ -116,6 +122,10 @@ func (b *Buffer) Close() error {
 	defer b.lock.Unlock()
 
 	b.closed = true
+	for _, w := range b.watchers {
+		w.close()
+	}
+	b.watchers = nil
 
 	return nil
 }
@@ -142,6 +152,21 @@ func (b *Buffer) Contents() []byte {
 	return contents
 }
 
+/*
+NumLines returns the number of complete lines written to the buffer so far.
+
+A line is complete once its terminating newline has been written.  A trailing partial line (data after the final newline)
+is not counted: a buffer containing "a\nb\n" has two lines, as does a buffer containing "a\nb\nc".
+
+NumLines counts lines across the entire buffer and is unaffected by the read cursor.
+*/
+func (b *Buffer) NumLines() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return bytes.Count(b.contents, []byte("\n"))
+}
+
 /*
 Detect takes a regular expression and returns a channel.
 
@@ -227,6 +252,141 @@ func (b *Buffer) CancelDetects() {
 	b.detectCloser = nil
 }
 
+// WatchChannelCapacity is the number of matches a Watch channel holds before further matches are dropped.
+const WatchChannelCapacity = 100
+
+/*
+Watch takes a regular expression and returns a channel that receives the matching bytes every time new data
+written to the buffer matches, along with a function that stops watching.
+
+Only data written after Watch is called is considered.  Matches are found as data is written, so a match that
+spans several writes is reported once the write completing it lands.  Unlike Say and Detect, Watch does not move
+the buffer's read cursor - any number of watchers can observe the same output alongside Say assertions.
+
+This enables reactive test logic:
+
+	ready, stop := buffer.Watch("listening on port \\d+")
+	defer stop()
+	<-ready
+	//start the client
+
+The channel is buffered to hold WatchChannelCapacity matches.  Matches are never allowed to block writes to the
+buffer: if the consumer falls behind and the channel is full, further matches are dropped until there is room again.
+
+Each write is scanned from the end of the previous match.  When the pattern cannot match a newline - as is the case for
+most patterns - output before the last newline that has been scanned without a match is skipped from then on, so
+watching costs time proportional to the output.  A pattern that can match a newline (one using \n, \s, [^x] or (?s),
+say) may span any amount of output, so until it matches every write rescans everything since its last match; avoid
+leaving such a watcher running over large volumes of output.
+
+Calling the returned function closes the channel and is safe to call more than once.  Closing the buffer closes all of its watch channels.
+*/
+func (b *Buffer) Watch(pattern string) (<-chan []byte, func()) {
+	w := &watcher{
+		re:          regexp.MustCompile(pattern),
+		matches:     make(chan []byte, WatchChannelCapacity),
+		withinALine: !canMatchNewline(pattern),
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.closed {
+		w.close()
+		return w.matches, func() {}
+	}
+
+	w.cursor = len(b.contents)
+	b.watchers = append(b.watchers, w)
+
+	return w.matches, func() {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+
+		for i, candidate := range b.watchers {
+			if candidate == w {
+				b.watchers = append(b.watchers[:i], b.watchers[i+1:]...)
+				break
+			}
+		}
+		w.close()
+	}
+}
+
+type watcher struct {
+	re          *regexp.Regexp
+	withinALine bool
+	cursor      int
+	matches     chan []byte
+	closeOnce   sync.Once
+}
+
+// scan reports every match in contents after the watcher's cursor.  It must be called with the buffer's lock held.
+func (w *watcher) scan(contents []byte) {
+	for w.cursor < len(contents) {
+		loc := w.re.FindIndex(contents[w.cursor:])
+		if loc == nil {
+			if w.withinALine {
+				if i := bytes.LastIndexByte(contents[w.cursor:], '\n'); i >= 0 {
+					w.cursor += i + 1
+				}
+			}
+			return
+		}
+
+		match := make([]byte, loc[1]-loc[0])
+		copy(match, contents[w.cursor+loc[0]:w.cursor+loc[1]])
+		select {
+		case w.matches <- match:
+		default:
+		}
+
+		if loc[1] == 0 {
+			w.cursor++
+		} else {
+			w.cursor += loc[1]
+		}
+	}
+}
+
+// canMatchNewline reports whether any match of pattern could contain a newline.
+func canMatchNewline(pattern string) bool {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return true
+	}
+	prog, err := syntax.Compile(re.Simplify())
+	if err != nil {
+		return true
+	}
+	for _, inst := range prog.Inst {
+		switch inst.Op {
+		case syntax.InstRuneAny:
+			return true
+		case syntax.InstRune, syntax.InstRune1:
+			if inst.MatchRune('\n') {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (w *watcher) close() {
+	w.closeOnce.Do(func() {
+		close(w.matches)
+	})
+}
+
+func (b *Buffer) unreadContents() ([]byte, bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	unread := make([]byte, uint64(len(b.contents))-b.readCursor)
+	copy(unread, b.contents[b.readCursor:])
+	return unread, b.closed
+}
+
 func (b *Buffer) didSay(re *regexp.Regexp) (bool, []byte) {
 	b.lock.Lock()
 	defer b.lock.Unlock()
@@ -1,7 +1,9 @@
 package gbytes_test
 
 import (
+	"fmt"
 	"io"
+	"sync"
 	"time"
 
 	. "github.com/onsi/gomega/gbytes"
@@ -181,6 +183,159 @@ var _ = Describe("Buffer", func() {
 		})
 	})
 
+	Describe("counting lines", func() {
+		It("should count newline-terminated lines", func() {
+			Expect(buffer.NumLines()).Should(Equal(0))
+
+			buffer.Write([]byte("abc\ndef\n"))
+			Expect(buffer.NumLines()).Should(Equal(2))
+		})
+
+		It("should not count a trailing partial line", func() {
+			buffer.Write([]byte("abc\nde"))
+			Expect(buffer.NumLines()).Should(Equal(1))
+
+			buffer.Write([]byte("f\n"))
+			Expect(buffer.NumLines()).Should(Equal(2))
+		})
+
+		It("should be unaffected by the read cursor", func() {
+			buffer.Write([]byte("abc\ndef\n"))
+			Expect(buffer).Should(Say("def"))
+			Expect(buffer.NumLines()).Should(Equal(2))
+		})
+	})
+
+	Describe("watching for regular expressions", func() {
+		It("should receive each new match", func() {
+			matches, stop := buffer.Watch(`request \d+`)
+			defer stop()
+
+			buffer.Write([]byte("request 1\nrequest 2\n"))
+			Eventually(matches).Should(Receive(Equal([]byte("request 1"))))
+			Eventually(matches).Should(Receive(Equal([]byte("request 2"))))
+
+			buffer.Write([]byte("noise\n"))
+			Consistently(matches, 0.05).ShouldNot(Receive())
+		})
+
+		It("should report a match that spans several writes", func() {
+			matches, stop := buffer.Watch("server ready")
+			defer stop()
+
+			buffer.Write([]byte("server "))
+			Consistently(matches, 0.05).ShouldNot(Receive())
+			buffer.Write([]byte("ready\n"))
+			Eventually(matches).Should(Receive(Equal([]byte("server ready"))))
+		})
+
+		It("should only consider data written after Watch is called", func() {
+			buffer.Write([]byte("stale\n"))
+			matches, stop := buffer.Watch("stale|fresh")
+			defer stop()
+
+			buffer.Write([]byte("fresh\n"))
+			Eventually(matches).Should(Receive(Equal([]byte("fresh"))))
+			Consistently(matches, 0.05).ShouldNot(Receive())
+		})
+
+		It("should still find single-line matches after skipping unmatched lines", func() {
+			matches, stop := buffer.Watch(`request \d+ done`)
+			defer stop()
+
+			buffer.Write([]byte("noise\nmore noise\nrequest 1"))
+			Consistently(matches, 0.05).ShouldNot(Receive())
+			buffer.Write([]byte(" done\n"))
+			Eventually(matches).Should(Receive(Equal([]byte("request 1 done"))))
+		})
+
+		It("should find matches spanning lines when the pattern can match a newline", func() {
+			matches, stop := buffer.Watch(`begin\s+end`)
+			defer stop()
+
+			buffer.Write([]byte("begin\n"))
+			buffer.Write([]byte("\n"))
+			buffer.Write([]byte("end"))
+			Eventually(matches).Should(Receive(Equal([]byte("begin\n\nend"))))
+		})
+
+		It("should not move the read cursor", func() {
+			matches, stop := buffer.Watch("abc")
+			defer stop()
+
+			buffer.Write([]byte("abc"))
+			Eventually(matches).Should(Receive())
+			Expect(buffer).Should(Say("abc"))
+		})
+
+		It("should support multiple concurrent watchers on different patterns", func() {
+			errors, stopErrors := buffer.Watch(`ERROR: \w+`)
+			defer stopErrors()
+			warnings, stopWarnings := buffer.Watch(`WARN: \w+`)
+			defer stopWarnings()
+
+			var lock sync.Mutex
+			received := map[string][]string{}
+			var wg sync.WaitGroup
+			consume := func(name string, c <-chan []byte) {
+				defer wg.Done()
+				for match := range c {
+					lock.Lock()
+					received[name] = append(received[name], string(match))
+					lock.Unlock()
+				}
+			}
+			wg.Add(2)
+			go consume("errors", errors)
+			go consume("warnings", warnings)
+
+			var writers sync.WaitGroup
+			for i := 0; i < 5; i++ {
+				writers.Add(1)
+				go func(i int) {
+					defer writers.Done()
+					buffer.Write([]byte(fmt.Sprintf("ERROR: e%d\n", i)))
+					buffer.Write([]byte(fmt.Sprintf("WARN: w%d\n", i)))
+				}(i)
+			}
+			writers.Wait()
+			buffer.Close()
+			wg.Wait()
+
+			Expect(received["errors"]).Should(ConsistOf("ERROR: e0", "ERROR: e1", "ERROR: e2", "ERROR: e3", "ERROR: e4"))
+			Expect(received["warnings"]).Should(ConsistOf("WARN: w0", "WARN: w1", "WARN: w2", "WARN: w3", "WARN: w4"))
+		})
+
+		It("should drop matches when the consumer falls behind", func() {
+			matches, stop := buffer.Watch("x")
+			defer stop()
+
+			for i := 0; i < WatchChannelCapacity+10; i++ {
+				buffer.Write([]byte("x"))
+			}
+			Expect(matches).Should(HaveLen(WatchChannelCapacity))
+		})
+
+		It("should close the channel when stopped", func() {
+			matches, stop := buffer.Watch("abc")
+			stop()
+			stop()
+			Expect(matches).Should(BeClosed())
+
+			buffer.Write([]byte("abc"))
+		})
+
+		It("should close the channel when the buffer is closed", func() {
+			matches, stop := buffer.Watch("abc")
+			buffer.Close()
+			Expect(matches).Should(BeClosed())
+			stop()
+
+			matches, _ = buffer.Watch("abc")
+			Expect(matches).Should(BeClosed())
+		})
+	})
+
 	Describe("closing the buffer", func() {
 		It("should error when further write attempts are made", func() {
 			_, err := buffer.Write([]byte("abc"))
@@ -0,0 +1,96 @@
+package gbytes
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/onsi/gomega/format"
+)
+
+/*
+HaveCleanText is a Gomega matcher that operates on gbytes.Buffers (and BufferProviders):
+
+	Expect(session.Out).Should(HaveCleanText())
+
+will succeed if the unread contents of the buffer are valid UTF-8 and contain no control characters other than
+newline and tab.  This catches accidental binary output or stray terminal escape codes in user-facing text.
+
+To allow a different set of control characters pass them in; they replace the default set:
+
+	Expect(session.Out).Should(HaveCleanText('\n', '\r', '\t'))
+
+On failure the byte offset (from the read cursor) of the first offending byte is reported.  If the buffer is still
+open, an incomplete UTF-8 sequence at the very end of the buffer is not treated as invalid - the rest of it may not
+have been written yet.  HaveCleanText does not move the read cursor.
+
+If the buffer is closed, the HaveCleanText matcher will tell Eventually to abort.
+*/
+func HaveCleanText(allowed ...rune) *haveCleanTextMatcher {
+	if len(allowed) == 0 {
+		allowed = []rune{'\n', '\t'}
+	}
+	return &haveCleanTextMatcher{allowed: allowed}
+}
+
+type haveCleanTextMatcher struct {
+	allowed []rune
+
+	offset  int
+	problem string
+}
+
+func (m *haveCleanTextMatcher) Match(actual interface{}) (success bool, err error) {
+	buffer, ok := bufferFor(actual)
+	if !ok {
+		return false, fmt.Errorf("HaveCleanText must be passed a *gbytes.Buffer or BufferProvider.  Got:\n%s", format.Object(actual, 1))
+	}
+
+	unread, closed := buffer.unreadContents()
+
+	for offset := 0; offset < len(unread); {
+		r, size := utf8.DecodeRune(unread[offset:])
+		if r == utf8.RuneError && size <= 1 {
+			if !closed && !utf8.FullRune(unread[offset:]) {
+				break
+			}
+			m.offset = offset
+			m.problem = fmt.Sprintf("invalid UTF-8 byte 0x%02x", unread[offset])
+			return false, nil
+		}
+		if unicode.IsControl(r) && !m.isAllowed(r) {
+			m.offset = offset
+			m.problem = fmt.Sprintf("disallowed control character %s", strconv.QuoteRune(r))
+			return false, nil
+		}
+		offset += size
+	}
+
+	return true, nil
+}
+
+func (m *haveCleanTextMatcher) isAllowed(r rune) bool {
+	for _, allowed := range m.allowed {
+		if r == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *haveCleanTextMatcher) FailureMessage(actual interface{}) (message string) {
+	return fmt.Sprintf("Expected buffer to contain clean text.  Found %s at byte offset %d of the unread output.", m.problem, m.offset)
+}
+
+func (m *haveCleanTextMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	return "Expected buffer not to contain clean text.  It does."
+}
+
+func (m *haveCleanTextMatcher) MatchMayChangeInTheFuture(actual interface{}) bool {
+	buffer, ok := bufferFor(actual)
+	if !ok {
+		return true
+	}
+	return !buffer.Closed()
+}
@@ -0,0 +1,91 @@
+package gbytes_test
+
+import (
+	"time"
+
+	. "github.com/onsi/gomega/gbytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HaveCleanText", func() {
+	var buffer *Buffer
+
+	BeforeEach(func() {
+		buffer = NewBuffer()
+	})
+
+	It("should succeed for clean text", func() {
+		Expect(buffer).Should(HaveCleanText())
+
+		buffer.Write([]byte("hello\tworld\nhéllo wörld ✓\n"))
+		Expect(buffer).Should(HaveCleanText())
+	})
+
+	It("should fail for invalid UTF-8, reporting the offset", func() {
+		buffer.Write([]byte("abc\xffdef"))
+
+		matcher := HaveCleanText()
+		Expect(matcher.Match(buffer)).Should(BeFalse())
+		Expect(matcher.FailureMessage(buffer)).Should(Equal("Expected buffer to contain clean text.  Found invalid UTF-8 byte 0xff at byte offset 3 of the unread output."))
+	})
+
+	It("should fail for stray control characters, reporting the offset", func() {
+		buffer.Write([]byte("ok\n\x1b[31mred"))
+
+		matcher := HaveCleanText()
+		Expect(matcher.Match(buffer)).Should(BeFalse())
+		Expect(matcher.FailureMessage(buffer)).Should(Equal(`Expected buffer to contain clean text.  Found disallowed control character '\x1b' at byte offset 3 of the unread output.`))
+	})
+
+	It("should allow a configurable set of control characters", func() {
+		buffer.Write([]byte("progress\r"))
+		Expect(buffer).ShouldNot(HaveCleanText())
+		Expect(buffer).Should(HaveCleanText('\n', '\r'))
+
+		buffer.Write([]byte("\t"))
+		Expect(buffer).ShouldNot(HaveCleanText('\n', '\r'))
+	})
+
+	It("should only check the unread contents and not move the read cursor", func() {
+		buffer.Write([]byte("\x00abc"))
+		Expect(buffer).ShouldNot(HaveCleanText())
+
+		Expect(buffer).Should(Say("\x00"))
+		Expect(buffer).Should(HaveCleanText())
+		Expect(buffer).Should(HaveCleanText())
+		Expect(buffer).Should(Say("abc"))
+	})
+
+	It("should tolerate an incomplete UTF-8 sequence at the end of an open buffer", func() {
+		buffer.Write([]byte("caf\xc3"))
+		Expect(buffer).Should(HaveCleanText())
+
+		buffer.Write([]byte("\xa9"))
+		Expect(buffer).Should(HaveCleanText())
+
+		buffer.Write([]byte("\xc3"))
+		buffer.Close()
+		Expect(buffer).ShouldNot(HaveCleanText())
+	})
+
+	It("should tell Eventually to abort once the buffer is closed", func() {
+		buffer.Write([]byte("\x00"))
+		Expect(HaveCleanText().MatchMayChangeInTheFuture(buffer)).Should(BeTrue())
+		buffer.Close()
+		Expect(HaveCleanText().MatchMayChangeInTheFuture(buffer)).Should(BeFalse())
+
+		t := time.Now()
+		failures := InterceptGomegaFailures(func() {
+			Eventually(buffer, 5).Should(HaveCleanText())
+		})
+		Expect(failures).Should(HaveLen(1))
+		Expect(time.Since(t)).Should(BeNumerically("<", time.Second))
+	})
+
+	It("should error when passed something that is not a buffer", func() {
+		_, err := HaveCleanText().Match("foo")
+		Expect(err).Should(HaveOccurred())
+	})
+})
@@ -0,0 +1,68 @@
+package gbytes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onsi/gomega/format"
+)
+
+/*
+HaveConsumedAll is a Gomega matcher that operates on gbytes.Buffers (and BufferProviders):
+
+	Expect(buffer).Should(HaveConsumedAll())
+
+will succeed if the buffer is closed and its read cursor has reached the end of its contents - i.e. every byte written
+to the buffer has been matched by a preceding Say (or read) and no more output can arrive.  Use it after a sequence of
+Say assertions to catch unexpected extra output:
+
+	Eventually(session).Should(Say("starting"))
+	Eventually(session).Should(Say("done"))
+	Eventually(session).Should(HaveConsumedAll())
+
+On failure the unconsumed output is printed.  HaveConsumedAll does not move the read cursor.
+
+If the buffer is closed, the HaveConsumedAll matcher will tell Eventually to abort.
+*/
+func HaveConsumedAll() *haveConsumedAllMatcher {
+	return &haveConsumedAllMatcher{}
+}
+
+type haveConsumedAllMatcher struct {
+	unread []byte
+	closed bool
+}
+
+func (m *haveConsumedAllMatcher) Match(actual interface{}) (success bool, err error) {
+	buffer, ok := bufferFor(actual)
+	if !ok {
+		return false, fmt.Errorf("HaveConsumedAll must be passed a *gbytes.Buffer or BufferProvider.  Got:\n%s", format.Object(actual, 1))
+	}
+
+	m.unread, m.closed = buffer.unreadContents()
+
+	return len(m.unread) == 0 && m.closed, nil
+}
+
+func (m *haveConsumedAllMatcher) FailureMessage(actual interface{}) (message string) {
+	message = "Expected buffer to be closed and fully consumed."
+	if !m.closed {
+		message += "  It is still open."
+	}
+	if len(m.unread) > 0 {
+		message += fmt.Sprintf("  It has unconsumed output:\n%s", format.IndentString(strings.TrimSuffix(string(m.unread), "\n"), 1))
+	}
+	return message
+}
+
+func (m *haveConsumedAllMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	return "Expected buffer not to be closed and fully consumed.  It is."
+}
+
+func (m *haveConsumedAllMatcher) MatchMayChangeInTheFuture(actual interface{}) bool {
+	buffer, ok := bufferFor(actual)
+	if !ok {
+		return true
+	}
+	return !buffer.Closed()
+}
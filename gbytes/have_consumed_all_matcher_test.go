@@ -0,0 +1,103 @@
+package gbytes_test
+
+import (
+	"time"
+
+	. "github.com/onsi/gomega/gbytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HaveConsumedAll", func() {
+	var buffer *Buffer
+
+	BeforeEach(func() {
+		buffer = NewBuffer()
+	})
+
+	When("actual is not a gexec Buffer, or a BufferProvider", func() {
+		It("should error", func() {
+			failures := InterceptGomegaFailures(func() {
+				Expect("foo").Should(HaveConsumedAll())
+			})
+			Expect(failures[0]).Should(ContainSubstring("*gbytes.Buffer"))
+		})
+	})
+
+	When("every byte has been said and the buffer is closed", func() {
+		It("should succeed", func() {
+			buffer.Write([]byte("starting\ndone\n"))
+			buffer.Close()
+
+			Expect(buffer).Should(Say("starting"))
+			Expect(buffer).Should(Say("done\n"))
+			Expect(buffer).Should(HaveConsumedAll())
+		})
+
+		It("should succeed for an empty closed buffer", func() {
+			buffer.Close()
+			Expect(buffer).Should(HaveConsumedAll())
+		})
+	})
+
+	When("output is left over", func() {
+		It("should fail and show the unconsumed tail", func() {
+			buffer.Write([]byte("starting\nunexpected warning\n"))
+			buffer.Close()
+			Expect(buffer).Should(Say("starting\n"))
+
+			matcher := HaveConsumedAll()
+			Expect(buffer).ShouldNot(matcher)
+			Expect(matcher.FailureMessage(buffer)).Should(Equal("Expected buffer to be closed and fully consumed.  It has unconsumed output:\n    unexpected warning"))
+		})
+
+		It("should not move the read cursor", func() {
+			buffer.Write([]byte("leftover"))
+			buffer.Close()
+			Expect(buffer).ShouldNot(HaveConsumedAll())
+			Expect(buffer).Should(Say("leftover"))
+			Expect(buffer).Should(HaveConsumedAll())
+		})
+	})
+
+	When("the buffer is still open", func() {
+		It("should fail even if everything so far has been said", func() {
+			buffer.Write([]byte("done"))
+			Expect(buffer).Should(Say("done"))
+
+			matcher := HaveConsumedAll()
+			Expect(buffer).ShouldNot(matcher)
+			Expect(matcher.FailureMessage(buffer)).Should(Equal("Expected buffer to be closed and fully consumed.  It is still open."))
+		})
+
+		It("should succeed with Eventually once the buffer closes", func() {
+			go func() {
+				time.Sleep(20 * time.Millisecond)
+				buffer.Close()
+			}()
+			Eventually(buffer).Should(HaveConsumedAll())
+		})
+	})
+
+	When("the buffer is closed with output left over", func() {
+		It("should abort an Eventually", func() {
+			buffer.Write([]byte("leftover"))
+			buffer.Close()
+
+			t := time.Now()
+			failures := InterceptGomegaFailures(func() {
+				Eventually(buffer).Should(HaveConsumedAll())
+			})
+			Expect(failures).Should(HaveLen(1))
+			Expect(time.Since(t)).Should(BeNumerically("<", 500*time.Millisecond))
+		})
+	})
+
+	When("passed a BufferProvider", func() {
+		It("should use the provided buffer", func() {
+			buffer.Close()
+			Expect(&speaker{buffer: buffer}).Should(HaveConsumedAll())
+		})
+	})
+})
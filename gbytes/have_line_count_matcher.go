@@ -0,0 +1,59 @@
+package gbytes
+
+import (
+	"fmt"
+
+	"github.com/onsi/gomega/format"
+)
+
+/*
+HaveLineCount is a Gomega matcher that operates on gbytes.Buffers (and BufferProviders):
+
+	Expect(buffer).Should(HaveLineCount(10))
+
+will succeed if exactly 10 complete lines have been written to the buffer.  Lines are counted with Buffer.NumLines,
+so a trailing partial line (one without a terminating newline) is not counted.
+
+HaveLineCount does not move the buffer's read cursor and pairs well with Eventually:
+
+	Eventually(session).Should(HaveLineCount(10))
+
+If the buffer is closed, the HaveLineCount matcher will tell Eventually to abort.
+*/
+func HaveLineCount(count int) *haveLineCountMatcher {
+	return &haveLineCountMatcher{
+		count: count,
+	}
+}
+
+type haveLineCountMatcher struct {
+	count       int
+	actualCount int
+}
+
+func (m *haveLineCountMatcher) Match(actual interface{}) (success bool, err error) {
+	buffer, ok := bufferFor(actual)
+	if !ok {
+		return false, fmt.Errorf("HaveLineCount must be passed a *gbytes.Buffer or BufferProvider.  Got:\n%s", format.Object(actual, 1))
+	}
+
+	m.actualCount = buffer.NumLines()
+
+	return m.actualCount == m.count, nil
+}
+
+func (m *haveLineCountMatcher) FailureMessage(actual interface{}) (message string) {
+	return fmt.Sprintf("Expected buffer to have %d lines.  It has %d.", m.count, m.actualCount)
+}
+
+func (m *haveLineCountMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	return fmt.Sprintf("Expected buffer not to have %d lines.  It does.", m.count)
+}
+
+func (m *haveLineCountMatcher) MatchMayChangeInTheFuture(actual interface{}) bool {
+	buffer, ok := bufferFor(actual)
+	if !ok {
+		return true
+	}
+	return !buffer.Closed()
+}
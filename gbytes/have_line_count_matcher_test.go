@@ -0,0 +1,84 @@
+package gbytes_test
+
+import (
+	"time"
+
+	. "github.com/onsi/gomega/gbytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HaveLineCount", func() {
+	var buffer *Buffer
+
+	BeforeEach(func() {
+		buffer = NewBuffer()
+	})
+
+	When("actual is not a gexec Buffer, or a BufferProvider", func() {
+		It("should error", func() {
+			failures := InterceptGomegaFailures(func() {
+				Expect("foo").Should(HaveLineCount(1))
+			})
+			Expect(failures[0]).Should(ContainSubstring("*gbytes.Buffer"))
+		})
+	})
+
+	When("the output ends with a newline", func() {
+		BeforeEach(func() {
+			buffer.Write([]byte("row 1\nrow 2\nrow 3\n"))
+		})
+
+		It("should count every line", func() {
+			Expect(buffer).Should(HaveLineCount(3))
+			Expect(buffer).ShouldNot(HaveLineCount(2))
+		})
+	})
+
+	When("the output does not end with a newline", func() {
+		BeforeEach(func() {
+			buffer.Write([]byte("row 1\nrow 2\nrow 3"))
+		})
+
+		It("should not count the trailing partial line", func() {
+			Expect(buffer).Should(HaveLineCount(2))
+			Expect(buffer).ShouldNot(HaveLineCount(3))
+		})
+	})
+
+	It("should work with BufferProviders", func() {
+		buffer.Write([]byte("row 1\n"))
+		Expect(&speaker{buffer}).Should(HaveLineCount(1))
+	})
+
+	It("should work with Eventually", func() {
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			buffer.Write([]byte("row 1\nrow 2\n"))
+		}()
+		Eventually(buffer).Should(HaveLineCount(2))
+	})
+
+	It("should have a descriptive failure message", func() {
+		buffer.Write([]byte("row 1\nrow 2"))
+		failures := InterceptGomegaFailures(func() {
+			Expect(buffer).Should(HaveLineCount(2))
+		})
+		Expect(failures[0]).Should(Equal("Expected buffer to have 2 lines.  It has 1."))
+	})
+
+	When("the buffer is closed", func() {
+		It("should abort an eventually", func() {
+			buffer.Write([]byte("row 1\n"))
+			buffer.Close()
+
+			t := time.Now()
+			failures := InterceptGomegaFailures(func() {
+				Eventually(buffer).Should(HaveLineCount(2))
+			})
+			Expect(time.Since(t)).Should(BeNumerically("<", 500*time.Millisecond))
+			Expect(failures).Should(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,91 @@
+package gbytes
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+/*
+SayGlob is like Say, but takes a glob pattern instead of a regular expression:
+
+	Eventually(session).Should(SayGlob("wrote build-*.tar.gz"))
+
+The pattern syntax is that of path.Match, except that the glob must match within a single line: '*' matches any run of
+characters other than '/' and newline, and '?' matches a single such character.  A character class '[...]' matches
+a single character and '\' escapes the next character.  As with Say, the glob may match anywhere in the unread
+portion of the buffer, and the read cursor is fast-forwarded past the match.
+
+SayGlob panics if the pattern is malformed.
+*/
+func SayGlob(pattern string) *sayMatcher {
+	if _, err := path.Match(pattern, ""); err != nil {
+		panic(fmt.Sprintf("SayGlob was passed an invalid glob pattern %q: %s", pattern, err))
+	}
+	return &sayMatcher{
+		re:   regexp.MustCompile(globToRegexp(pattern)),
+		glob: pattern,
+	}
+}
+
+// globToRegexp translates a well-formed path.Match pattern into an equivalent, unanchored, regular expression.
+func globToRegexp(pattern string) string {
+	re := &strings.Builder{}
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			re.WriteString(`[^/\n]*`)
+			pattern = pattern[1:]
+		case '?':
+			re.WriteString(`[^/\n]`)
+			pattern = pattern[1:]
+		case '[':
+			pattern = pattern[1:]
+			negated := pattern[0] == '^'
+			if negated {
+				pattern = pattern[1:]
+			}
+			ranges := ""
+			for first := true; first || pattern[0] != ']'; first = false {
+				var lo, hi rune
+				lo, pattern = globEscapedRune(pattern)
+				hi = lo
+				if pattern[0] == '-' {
+					hi, pattern = globEscapedRune(pattern[1:])
+				}
+				//path.Match accepts reversed ranges such as [b-a], which match nothing
+				if lo <= hi {
+					ranges += regexpClassRune(lo) + "-" + regexpClassRune(hi)
+				}
+			}
+			pattern = pattern[1:]
+			switch {
+			case negated:
+				re.WriteString(`[^\n` + ranges + "]")
+			case ranges == "":
+				re.WriteString(`[^\x00-\x{10FFFF}]`)
+			default:
+				re.WriteString("[" + ranges + "]")
+			}
+		default:
+			var r rune
+			r, pattern = globEscapedRune(pattern)
+			re.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	return re.String()
+}
+
+func globEscapedRune(pattern string) (rune, string) {
+	if pattern[0] == '\\' {
+		pattern = pattern[1:]
+	}
+	r, size := utf8.DecodeRuneInString(pattern)
+	return r, pattern[size:]
+}
+
+func regexpClassRune(r rune) string {
+	return fmt.Sprintf(`\x{%x}`, r)
+}
@@ -0,0 +1,56 @@
+package gbytes_test
+
+import (
+	. "github.com/onsi/gomega/gbytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SayGlob", func() {
+	var buffer *Buffer
+
+	BeforeEach(func() {
+		buffer = NewBuffer()
+		buffer.Write([]byte("wrote build-1.2.3.tar.gz\nwrote out/logs.txt\n"))
+	})
+
+	It("should match a glob anywhere in the unread output and fast-forward past it", func() {
+		Expect(buffer).Should(SayGlob("build-*.tar.gz"))
+		Expect(buffer).ShouldNot(SayGlob("build-*"))
+		Expect(buffer).Should(SayGlob("out/*.txt"))
+	})
+
+	It("should not let wildcards cross '/' or line boundaries", func() {
+		Expect(buffer).ShouldNot(SayGlob("wrote *.txt"))
+		Expect(buffer).ShouldNot(SayGlob("gz*wrote"))
+		Expect(buffer).ShouldNot(SayGlob("gz?wrote"))
+		Expect(buffer).Should(SayGlob("wrote ???/logs.txt"))
+	})
+
+	It("should support character classes and escapes", func() {
+		Expect(buffer).Should(SayGlob("build-[0-9].[^a-z].[13]"))
+		Expect(buffer).ShouldNot(SayGlob(`out/\*`))
+		buffer.Write([]byte("literal *star* and [brackets]\n"))
+		Expect(buffer).Should(SayGlob(`\*star\* and \[brackets]`))
+	})
+
+	It("should treat reversed ranges as matching nothing, as path.Match does", func() {
+		Expect(func() { SayGlob("build-[9-0]") }).ShouldNot(Panic())
+		Expect(buffer).ShouldNot(SayGlob("build-[9-0]"))
+		Expect(buffer).Should(SayGlob("build-[^9-0].2"))
+		buffer.Write([]byte("build-1\n"))
+		Expect(buffer).Should(SayGlob("build-[9-01]"))
+	})
+
+	It("should show the glob in the failure message", func() {
+		matcher := SayGlob("release-*.zip")
+		Expect(matcher.Match(buffer)).Should(BeFalse())
+		Expect(matcher.FailureMessage(buffer)).Should(HaveSuffix("Waiting for:\n    release-*.zip (glob)"))
+	})
+
+	It("should panic at construction when given an invalid pattern", func() {
+		Expect(func() { SayGlob("build-[") }).Should(Panic())
+		Expect(func() { SayGlob(`build-\`) }).Should(Panic())
+	})
+})
@@ -5,6 +5,7 @@ package gbytes
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/onsi/gomega/format"
 )
@@ -14,6 +15,21 @@ type BufferProvider interface {
 	Buffer() *Buffer
 }
 
+func bufferFor(actual interface{}) (*Buffer, bool) {
+	var buffer *Buffer
+
+	switch x := actual.(type) {
+	case *Buffer:
+		buffer = x
+	case BufferProvider:
+		buffer = x.Buffer()
+	default:
+		return nil, false
+	}
+
+	return buffer, true
+}
+
 /*
 Say is a Gomega matcher that operates on gbytes.Buffers:
 
@@ -36,6 +52,10 @@ In addition to bytes.Buffers, Say can operate on objects that implement the gbyt
 In such cases, Say simply operates on the *gbytes.Buffer returned by Buffer()
 
 If the buffer is closed, the Say matcher will tell Eventually to abort.
+
+To match regardless of case, call CaseInsensitive on the returned matcher:
+
+	Eventually(buffer).Should(Say("ready").CaseInsensitive())
 */
 func Say(expected string, args ...interface{}) *sayMatcher {
 	if len(args) > 0 {
@@ -48,22 +68,37 @@ func Say(expected string, args ...interface{}) *sayMatcher {
 
 type sayMatcher struct {
 	re              *regexp.Regexp
+	glob            string
+	caseInsensitive bool
 	receivedSayings []byte
 }
 
-func (m *sayMatcher) buffer(actual interface{}) (*Buffer, bool) {
-	var buffer *Buffer
+/*
+CaseInsensitive makes the Say matcher ignore case when matching, so that Say("ready").CaseInsensitive() matches "Ready", "READY", etc.
 
-	switch x := actual.(type) {
-	case *Buffer:
-		buffer = x
-	case BufferProvider:
-		buffer = x.Buffer()
-	default:
-		return nil, false
+This is equivalent to prefixing the regular expression with (?i).
+*/
+func (m *sayMatcher) CaseInsensitive() *sayMatcher {
+	if !m.caseInsensitive {
+		m.re = regexp.MustCompile("(?i)" + m.re.String())
+		m.caseInsensitive = true
 	}
+	return m
+}
 
-	return buffer, true
+func (m *sayMatcher) expectation() string {
+	expectation := m.re.String()
+	if m.glob != "" {
+		expectation = m.glob + " (glob)"
+	}
+	if m.caseInsensitive {
+		expectation = strings.TrimPrefix(expectation, "(?i)") + " (case-insensitive)"
+	}
+	return expectation
+}
+
+func (m *sayMatcher) buffer(actual interface{}) (*Buffer, bool) {
+	return bufferFor(actual)
 }
 
 func (m *sayMatcher) Match(actual interface{}) (success bool, err error) {
@@ -82,7 +117,7 @@ func (m *sayMatcher) FailureMessage(actual interface{}) (message string) {
 	return fmt.Sprintf(
 		"Got stuck at:\n%s\nWaiting for:\n%s",
 		format.IndentString(string(m.receivedSayings), 1),
-		format.IndentString(m.re.String(), 1),
+		format.IndentString(m.expectation(), 1),
 	)
 }
 
@@ -90,7 +125,7 @@ func (m *sayMatcher) NegatedFailureMessage(actual interface{}) (message string)
 	return fmt.Sprintf(
 		"Saw:\n%s\nWhich matches the unexpected:\n%s",
 		format.IndentString(string(m.receivedSayings), 1),
-		format.IndentString(m.re.String(), 1),
+		format.IndentString(m.expectation(), 1),
 	)
 }
 
@@ -127,6 +127,33 @@ var _ = Describe("SayMatcher", func() {
 		})
 	})
 
+	Context("matching case-insensitively", func() {
+		BeforeEach(func() {
+			buffer.Write([]byte("\nServer is Ready\n"))
+		})
+
+		It("should only match mixed-case output when asked to", func() {
+			Expect(buffer).ShouldNot(Say("ready"))
+			Expect(buffer).Should(Say("ready").CaseInsensitive())
+		})
+
+		It("should support printf-like formatting", func() {
+			Expect(buffer).Should(Say("SERVER IS %s", "READY").CaseInsensitive())
+		})
+
+		It("should note that case-insensitivity was applied when a match fails", func() {
+			failures := InterceptGomegaFailures(func() {
+				Expect(buffer).Should(Say("stopped").CaseInsensitive())
+			})
+			Expect(failures[0]).Should(ContainSubstring("Waiting for:\n    stopped (case-insensitive)"))
+
+			failures = InterceptGomegaFailures(func() {
+				Expect(buffer).ShouldNot(Say("READY").CaseInsensitive())
+			})
+			Expect(failures[0]).Should(ContainSubstring("Which matches the unexpected:\n    READY (case-insensitive)"))
+		})
+	})
+
 	Context("a nice real-life example", func() {
 		It("should behave well", func() {
 			Expect(buffer).Should(Say("abc"))
@@ -0,0 +1,40 @@
+package gexec
+
+import (
+	"fmt"
+
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/format"
+)
+
+/*
+AssertExit waits for the session to exit and asserts that it exited with the passed-in code.
+If the session exits with a different code, or does not exit in time, the failure message includes
+the last OutputTailLines lines of both stdout and stderr:
+
+	gexec.AssertExit(session, 0)
+	gexec.AssertExit(session, 1, 5*time.Second)
+
+AssertExit uses Eventually under the hood and accepts the same timeout/polling intervals that Eventually does.
+If no timeout is passed in, AssertExit uses the session's default timeout (see Session.WithDefaultTimeout).
+It returns the session, making it possible to chain.
+*/
+func AssertExit(session *Session, exitCode int, timeout ...interface{}) *Session {
+	EventuallyWithOffset(1, session, session.intervals(timeout)...).Should(Exit(exitCode), OutputTail(session))
+	return session
+}
+
+/*
+OutputTail returns a lazily-evaluated assertion description holding the last OutputTailLines lines of the session's stdout and stderr.
+Pass it to any assertion involving the session to have the failure message include the process's recent output:
+
+	Eventually(session).Should(gbytes.Say("listening"), gexec.OutputTail(session))
+*/
+func OutputTail(session *Session) func() string {
+	return func() string {
+		tailLines := OutputTailLines
+		return fmt.Sprintf("Stdout (last %d lines):\n%s\nStderr (last %d lines):\n%s",
+			tailLines, format.IndentString(string(tail(session.Out.Contents(), tailLines)), 1),
+			tailLines, format.IndentString(string(tail(session.Err.Contents(), tailLines)), 1))
+	}
+}
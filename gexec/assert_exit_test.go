@@ -0,0 +1,68 @@
+// +build !windows
+
+package gexec_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("AssertExit", func() {
+	It("passes when the session exits with the expected code", func() {
+		session := startScript("exit 3")
+		Expect(AssertExit(session, 3)).To(Equal(session))
+	})
+
+	When("the session exits with a different code", func() {
+		var originalTailLines int
+
+		BeforeEach(func() {
+			originalTailLines = OutputTailLines
+		})
+
+		AfterEach(func() {
+			OutputTailLines = originalTailLines
+		})
+
+		It("includes the tail of stdout and stderr in the failure", func() {
+			session := startScript("echo starting up; echo first problem >&2; echo disk full >&2; exit 1")
+
+			failures := InterceptGomegaFailures(func() {
+				AssertExit(session, 0)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("to match exit code:"))
+			Expect(failures[0]).To(ContainSubstring("Stdout (last 20 lines):\n    starting up\n"))
+			Expect(failures[0]).To(ContainSubstring("Stderr (last 20 lines):\n    first problem\n    disk full\n"))
+		})
+
+		It("respects OutputTailLines", func() {
+			OutputTailLines = 1
+			session := startScript("echo first problem >&2; echo disk full >&2; exit 1")
+
+			failures := InterceptGomegaFailures(func() {
+				AssertExit(session, 0)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Stderr (last 1 lines):\n    disk full\n"))
+			Expect(failures[0]).NotTo(ContainSubstring("first problem"))
+		})
+	})
+
+	It("fails with the output so far when the session does not exit in time", func() {
+		session := startScript("echo still going; exec sleep 10")
+		defer func() {
+			session.Kill().Wait()
+		}()
+		Eventually(session).Should(Say("still going"))
+
+		failures := InterceptGomegaFailures(func() {
+			AssertExit(session, 0, 0.1)
+		})
+		Expect(failures).To(HaveLen(1))
+		Expect(failures[0]).To(ContainSubstring("Timed out after"))
+		Expect(failures[0]).To(ContainSubstring("still going"))
+	})
+})
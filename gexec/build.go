@@ -3,6 +3,8 @@
 package gexec
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"go/build"
@@ -29,21 +31,40 @@ Build uses the $GOPATH set in your environment. If $GOPATH is not set and you ar
 it will use the default GOPATH instead.  It passes the variadic args on to `go build`.
 */
 func Build(packagePath string, args ...string) (compiledPath string, err error) {
-	return doBuild(build.Default.GOPATH, packagePath, nil, args...)
+	return doBuild(context.Background(), build.Default.GOPATH, packagePath, nil, args...)
+}
+
+/*
+BuildContext is identical to Build but aborts the compilation when the passed-in context is done.
+
+If ctx is done before the build completes, the go build process (and any processes it has spawned) is killed,
+the partially built artifact is discarded, and BuildContext returns ctx.Err().  This is useful for bounding
+the time a suite is willing to spend on a compilation:
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	compiledPath, err := gexec.BuildContext(ctx, "github.com/me/mypackage")
+
+When ctx can be cancelled, go build runs in its own process group so that it can be killed as a whole.  It is then no
+longer in the terminal's foreground process group, so a Ctrl-C does not reach it directly - cancel ctx instead.  Build,
+BuildIn and BuildWithEnvironment, and BuildContext with a context that can never be done, run go build as before.
+*/
+func BuildContext(ctx context.Context, packagePath string, args ...string) (compiledPath string, err error) {
+	return doBuild(ctx, build.Default.GOPATH, packagePath, nil, args...)
 }
 
 /*
 BuildWithEnvironment is identical to Build but allows you to specify env vars to be set at build time.
 */
 func BuildWithEnvironment(packagePath string, env []string, args ...string) (compiledPath string, err error) {
-	return doBuild(build.Default.GOPATH, packagePath, env, args...)
+	return doBuild(context.Background(), build.Default.GOPATH, packagePath, env, args...)
 }
 
 /*
 BuildIn is identical to Build but allows you to specify a custom $GOPATH (the first argument).
 */
 func BuildIn(gopath string, packagePath string, args ...string) (compiledPath string, err error) {
-	return doBuild(gopath, packagePath, nil, args...)
+	return doBuild(context.Background(), gopath, packagePath, nil, args...)
 }
 
 func replaceGoPath(environ []string, newGoPath string) []string {
@@ -56,7 +77,11 @@ func replaceGoPath(environ []string, newGoPath string) []string {
 	return append(newEnviron, "GOPATH="+newGoPath)
 }
 
-func doBuild(gopath, packagePath string, env []string, args ...string) (compiledPath string, err error) {
+func doBuild(ctx context.Context, gopath, packagePath string, env []string, args ...string) (compiledPath string, err error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
 	tmpDir, err := temporaryDirectory()
 	if err != nil {
 		return "", err
@@ -78,9 +103,39 @@ func doBuild(gopath, packagePath string, env []string, args ...string) (compiled
 	build.Env = replaceGoPath(os.Environ(), gopath)
 	build.Env = append(build.Env, env...)
 
-	output, err := build.CombinedOutput()
+	if ctx.Done() == nil {
+		output, err := build.CombinedOutput()
+		if err != nil {
+			return "", fmt.Errorf("Failed to build %s:\n\nError:\n%s\n\nOutput:\n%s", packagePath, err, string(output))
+		}
+		return executable, nil
+	}
+
+	//the build gets its own process group so that cancelling it also kills the compiler processes go build spawns
+	output := &bytes.Buffer{}
+	build.Stdout = output
+	build.Stderr = output
+	setProcessGroup(build)
+
+	err = build.Start()
+	if err == nil {
+		done := make(chan error, 1)
+		go func() {
+			done <- build.Wait()
+		}()
+
+		select {
+		case err = <-done:
+		case <-ctx.Done():
+			killProcessGroup(build)
+			<-done
+			os.RemoveAll(tmpDir)
+			return "", ctx.Err()
+		}
+	}
+
 	if err != nil {
-		return "", fmt.Errorf("Failed to build %s:\n\nError:\n%s\n\nOutput:\n%s", packagePath, err, string(output))
+		return "", fmt.Errorf("Failed to build %s:\n\nError:\n%s\n\nOutput:\n%s", packagePath, err, output.String())
 	}
 
 	return executable, nil
@@ -0,0 +1,30 @@
+package gexec
+
+import (
+	"debug/buildinfo"
+	"fmt"
+	"runtime/debug"
+)
+
+/*
+BuildInfo reads the build information embedded in the compiled Go binary at path - the Go version it was built with,
+its main module and dependencies, and build settings such as -trimpath and the VCS revision:
+
+	compiledPath, err := gexec.Build("github.com/me/my-cli", "-trimpath")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	info, err := gexec.BuildInfo(compiledPath)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(info.Main.Path).Should(Equal("github.com/me/my-cli"))
+	Expect(info.Settings).Should(ContainElement(debug.BuildSetting{Key: "-trimpath", Value: "true"}))
+
+BuildInfo does not run the binary.  It returns an error if path is not a Go binary or carries no build information,
+as is the case for binaries built before Go 1.18 or with their build information stripped.
+*/
+func BuildInfo(path string) (*debug.BuildInfo, error) {
+	info, err := buildinfo.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read build info from %s: %s", path, err)
+	}
+	return info, nil
+}
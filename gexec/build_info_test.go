@@ -0,0 +1,48 @@
+package gexec_test
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+	"runtime/debug"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("BuildInfo", func() {
+	It("reads the build info of a compiled binary", func() {
+		info, err := gexec.BuildInfo(fireflyPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Main.Path).To(Equal("github.com/onsi/gomega"))
+		Expect(info.Path).To(Equal("github.com/onsi/gomega/gexec/_fixture/firefly"))
+		Expect(info.GoVersion).To(Equal(runtime.Version()))
+	})
+
+	It("reflects the flags the binary was built with", func() {
+		compiledPath, err := gexec.Build("./_fixture/firefly", "-trimpath")
+		Expect(err).NotTo(HaveOccurred())
+
+		info, err := gexec.BuildInfo(compiledPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Settings).To(ContainElement(debug.BuildSetting{Key: "-trimpath", Value: "true"}))
+	})
+
+	It("errors for files that aren't Go binaries", func() {
+		file, err := ioutil.TempFile("", "not-a-binary")
+		Expect(err).NotTo(HaveOccurred())
+		file.WriteString("#!/bin/sh\necho hi\n")
+		file.Close()
+		defer os.Remove(file.Name())
+
+		info, err := gexec.BuildInfo(file.Name())
+		Expect(info).To(BeNil())
+		Expect(err).To(MatchError(ContainSubstring("could not read build info from " + file.Name())))
+	})
+
+	It("errors for files that don't exist", func() {
+		_, err := gexec.BuildInfo("/no/such/binary")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -1,10 +1,13 @@
 package gexec_test
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"runtime"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -41,6 +44,60 @@ var _ = Describe(".Build", func() {
 	})
 })
 
+var _ = Describe(".BuildContext", func() {
+	It("compiles the specified package", func() {
+		compiledPath, err := gexec.BuildContext(context.Background(), packagePath)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(compiledPath).Should(BeAnExistingFile())
+	})
+
+	It("returns the context's error without building if the context is already done", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		compiledPath, err := gexec.BuildContext(ctx, packagePath)
+		Expect(err).Should(Equal(context.Canceled))
+		Expect(compiledPath).Should(BeEmpty())
+	})
+
+	Context("when the build hangs", func() {
+		var originalPath, stubDir string
+
+		BeforeEach(func() {
+			if runtime.GOOS == "windows" {
+				Skip("the stub go binary is a shell script")
+			}
+
+			var err error
+			stubDir, err = ioutil.TempDir("", "gexec_stub_go")
+			Expect(err).NotTo(HaveOccurred())
+			stub := "#!/bin/sh\nsleep 10\n"
+			Expect(ioutil.WriteFile(filepath.Join(stubDir, "go"), []byte(stub), 0755)).To(Succeed())
+
+			originalPath = os.Getenv("PATH")
+			Expect(os.Setenv("PATH", stubDir+string(os.PathListSeparator)+originalPath)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			if stubDir != "" {
+				Expect(os.Setenv("PATH", originalPath)).To(Succeed())
+				os.RemoveAll(stubDir)
+			}
+		})
+
+		It("kills the build and its children once the context times out", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+
+			t := time.Now()
+			compiledPath, err := gexec.BuildContext(ctx, packagePath)
+			Expect(time.Since(t)).Should(BeNumerically("<", 2*time.Second))
+			Expect(err).Should(Equal(context.DeadlineExceeded))
+			Expect(compiledPath).Should(BeEmpty())
+		})
+	})
+})
+
 var _ = Describe(".BuildWithEnvironment", func() {
 	var err error
 	env := []string{
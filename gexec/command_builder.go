@@ -0,0 +1,152 @@
+package gexec
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"time"
+)
+
+/*
+CommandBuilder assembles a command and the way it should be run in a single expression.  Create one with New:
+
+	session, err := gexec.New(pathToCLI, "serve", "--port", "8080").
+		WithDir(fixturesDir).
+		WithEnv(map[string]string{"LOG_LEVEL": "debug"}).
+		WithOutput(GinkgoWriter, GinkgoWriter).
+		WithTimeout(30 * time.Second).
+		Start()
+
+Start validates the command with ValidateCommand before running it.  The resulting session is tracked like any other,
+so the package-level Kill, KillAndWait, Terminate and TerminateAndWait clean it up; WithCleanup additionally registers
+the cleanup with a testing.T.
+
+CommandBuilder is a thin layer over the raw API: Command returns the *exec.Cmd it would run, and Start is equivalent to
+calling gexec.Start with the configured writers and options and then Session.WithDefaultTimeout.
+*/
+type CommandBuilder struct {
+	name      string
+	args      []string
+	dir       string
+	env       map[string]string
+	timeout   time.Duration
+	outWriter io.Writer
+	errWriter io.Writer
+	options   []StartOption
+	cleanup   func(func())
+}
+
+/*
+New returns a CommandBuilder for running name with the passed-in arguments.  name is resolved as exec.Command resolves it.
+*/
+func New(name string, args ...string) *CommandBuilder {
+	return &CommandBuilder{
+		name: name,
+		args: args,
+	}
+}
+
+//WithDir sets the command's working directory.
+func (b *CommandBuilder) WithDir(dir string) *CommandBuilder {
+	b.dir = dir
+	return b
+}
+
+//WithEnv sets environment variables for the command, on top of the current process's environment.
+//Calling WithEnv more than once merges the maps, with later values winning.
+func (b *CommandBuilder) WithEnv(env map[string]string) *CommandBuilder {
+	if b.env == nil {
+		b.env = map[string]string{}
+	}
+	for key, value := range env {
+		b.env[key] = value
+	}
+	return b
+}
+
+//WithTimeout sets the session's default timeout.  See Session.WithDefaultTimeout.
+func (b *CommandBuilder) WithTimeout(timeout time.Duration) *CommandBuilder {
+	b.timeout = timeout
+	return b
+}
+
+//WithOutput sets the writers that the command's stdout and stderr are forwarded to, in addition to the session's buffers.
+//By default output is only captured in the buffers.
+func (b *CommandBuilder) WithOutput(outWriter io.Writer, errWriter io.Writer) *CommandBuilder {
+	b.outWriter = outWriter
+	b.errWriter = errWriter
+	return b
+}
+
+//WithOptions adds StartOptions to pass to Start.
+func (b *CommandBuilder) WithOptions(options ...StartOption) *CommandBuilder {
+	b.options = append(b.options, options...)
+	return b
+}
+
+//WithCleanup registers a function, run when the test finishes, that kills the session and waits for it to exit.
+//Pass testing.T's Cleanup method:
+//
+//	session, err := gexec.New(pathToCLI).WithCleanup(t.Cleanup).Start()
+func (b *CommandBuilder) WithCleanup(register func(func())) *CommandBuilder {
+	b.cleanup = register
+	return b
+}
+
+/*
+Command returns a new *exec.Cmd configured with the builder's name, arguments, directory and environment.
+*/
+func (b *CommandBuilder) Command() *exec.Cmd {
+	command := exec.Command(b.name, b.args...)
+	command.Dir = b.dir
+	if len(b.env) > 0 {
+		command.Env = append(os.Environ(), b.environment()...)
+	}
+	return command
+}
+
+/*
+Start validates and starts the command, returning its session.
+*/
+func (b *CommandBuilder) Start() (*Session, error) {
+	command := b.Command()
+	if err := ValidateCommand(command); err != nil {
+		return nil, err
+	}
+
+	factory := &SessionFactory{
+		OutWriter: b.outWriter,
+		ErrWriter: b.errWriter,
+		Options:   b.options,
+	}
+	session, err := factory.Start(command)
+	if err != nil {
+		return nil, err
+	}
+
+	session.WithDefaultTimeout(b.timeout)
+	if b.cleanup != nil {
+		b.cleanup(func() {
+			session.Kill()
+			<-session.Exited
+		})
+	}
+
+	return session, nil
+}
+
+func (b *CommandBuilder) environment() []string {
+	keys := make([]string, 0, len(b.env))
+	for key := range b.env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	env := make([]string, 0, len(keys))
+	for _, key := range keys {
+		env = append(env, fmt.Sprintf("%s=%s", key, b.env[key]))
+	}
+	return env
+}
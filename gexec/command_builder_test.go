@@ -0,0 +1,103 @@
+// +build !windows
+
+package gexec_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("CommandBuilder", func() {
+	It("should run the command in the configured directory", func() {
+		dir, err := ioutil.TempDir("", "builder-dir")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+		dir, err = filepath.EvalSymlinks(dir)
+		Expect(err).NotTo(HaveOccurred())
+
+		session, err := New("pwd").WithDir(dir).Start()
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(session).Should(Exit(0))
+		Expect(session.Out).Should(Say(dir))
+	})
+
+	It("should layer the configured environment on top of the current one", func() {
+		os.Setenv("BUILDER_INHERITED", "inherited")
+		defer os.Unsetenv("BUILDER_INHERITED")
+
+		session, err := New("sh", "-c", "echo $BUILDER_INHERITED $BUILDER_A $BUILDER_B").
+			WithEnv(map[string]string{"BUILDER_A": "a", "BUILDER_B": "b"}).
+			WithEnv(map[string]string{"BUILDER_B": "B"}).
+			Start()
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(session).Should(Exit(0))
+		Expect(session.Out).Should(Say("inherited a B"))
+	})
+
+	It("should apply the timeout as the session's default timeout", func() {
+		session, err := New("sleep", "10").WithTimeout(100 * time.Millisecond).Start()
+		Expect(err).NotTo(HaveOccurred())
+		defer func() {
+			session.Kill().Wait(time.Second)
+		}()
+
+		t := time.Now()
+		failures := InterceptGomegaFailures(func() {
+			session.Wait()
+		})
+		Expect(failures).Should(HaveLen(1))
+		Expect(time.Since(t)).Should(And(BeNumerically(">=", 100*time.Millisecond), BeNumerically("<", 800*time.Millisecond)))
+	})
+
+	It("should forward output to the configured writers and apply options", func() {
+		outWriter, errWriter := NewBuffer(), NewBuffer()
+		session, err := New("sh", "-c", `printf '\033[31mout\033[0m\n'; echo err >&2`).
+			WithOutput(outWriter, errWriter).
+			WithOptions(StripANSIFromBuffers()).
+			Start()
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(session).Should(Exit(0))
+
+		Expect(string(session.Out.Contents())).Should(Equal("out\n"))
+		Expect(string(outWriter.Contents())).Should(Equal("\033[31mout\033[0m\n"))
+		Expect(string(errWriter.Contents())).Should(Equal("err\n"))
+	})
+
+	It("should register a cleanup that kills the session", func() {
+		var cleanup func()
+		session, err := New("sleep", "10").WithCleanup(func(f func()) { cleanup = f }).Start()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cleanup).NotTo(BeNil())
+
+		cleanup()
+		Expect(session).Should(Exit())
+		Expect(session.KilledForcefully()).Should(BeTrue())
+	})
+
+	It("should validate the command before starting it", func() {
+		session, err := New("sleep", "10").WithDir("/does/not/exist").Start()
+		Expect(err).Should(MatchError(ContainSubstring("/does/not/exist")))
+		Expect(session).Should(BeNil())
+
+		_, err = New("definitely-not-a-real-command-gexec").Start()
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("should expose the underlying command for use with the raw API", func() {
+		command := New("echo", "hi").WithDir("/tmp").WithEnv(map[string]string{"A": "1"}).Command()
+		Expect(command.Args).Should(Equal([]string{"echo", "hi"}))
+		Expect(command.Dir).Should(Equal("/tmp"))
+		Expect(command.Env).Should(ContainElement("A=1"))
+
+		session, err := Start(command, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(session).Should(Say("hi"))
+	})
+})
@@ -0,0 +1,70 @@
+package gexec
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/onsi/gomega/format"
+)
+
+// OutputTailLines sets how many trailing lines of stdout and stderr DumpSessions, AssertExit and OutputTail include for each session.
+var OutputTailLines = 20
+
+/*
+DumpSessions writes a summary of every session started with Start to the passed in writer.
+For each session it emits the command line, whether the command is still running (or the code it exited with),
+and the last OutputTailLines lines of both stdout and stderr.
+
+DumpSessions is intended to be called when a test fails so that the state of every process involved in
+the test lands in the failure report.  With Ginkgo you might:
+
+	AfterEach(func() {
+		if CurrentGinkgoTestDescription().Failed {
+			gexec.DumpSessions(GinkgoWriter)
+		}
+	})
+*/
+func DumpSessions(w io.Writer) {
+	trackedSessionsMutex.Lock()
+	defer trackedSessionsMutex.Unlock()
+
+	for i, session := range trackedSessions {
+		fmt.Fprintf(w, "Session %d: %s\n", i+1, session.dump(OutputTailLines))
+	}
+}
+
+func (s *Session) dump(tailLines int) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state := fmt.Sprintf("running (pid %d)", s.pid)
+	if s.exitCode != -1 {
+		state = fmt.Sprintf("exited with code %d", s.exitCode)
+	}
+
+	dump := &strings.Builder{}
+	fmt.Fprintln(dump, strings.Join(s.Command.Args, " "))
+	fmt.Fprintf(dump, "%sState: %s\n", format.Indent, state)
+	fmt.Fprintf(dump, "%sStdout (last %d lines):\n%s", format.Indent, tailLines, format.IndentString(string(tail(s.Out.Contents(), tailLines)), 2))
+	fmt.Fprintf(dump, "\n%sStderr (last %d lines):\n%s", format.Indent, tailLines, format.IndentString(string(tail(s.Err.Contents(), tailLines)), 2))
+	return dump.String()
+}
+
+// tail returns the last n lines of output.  A trailing partial line counts as a line.
+func tail(output []byte, n int) []byte {
+	output = bytes.TrimSuffix(output, []byte("\n"))
+	if n <= 0 || len(output) == 0 {
+		return []byte{}
+	}
+
+	cursor := len(output)
+	for i := 0; i < n; i++ {
+		cursor = bytes.LastIndexByte(output[:cursor], '\n')
+		if cursor == -1 {
+			return output
+		}
+	}
+	return output[cursor+1:]
+}
@@ -0,0 +1,13 @@
+package gexec
+
+import "os"
+
+var GetExitCode = getExitCode
+
+func SetExitCodeFromProcessState(extractor func(*os.ProcessState) int) (restore func()) {
+	original := exitCodeFromProcessState
+	exitCodeFromProcessState = extractor
+	return func() {
+		exitCodeFromProcessState = original
+	}
+}
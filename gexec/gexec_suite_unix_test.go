@@ -0,0 +1,16 @@
+// +build !windows
+
+package gexec_test
+
+import (
+	"os/exec"
+
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gexec"
+)
+
+func startScript(script string) *gexec.Session {
+	session, err := gexec.Start(exec.Command("sh", "-c", script), nil, nil)
+	Expect(err).ShouldNot(HaveOccurred())
+	return session
+}
@@ -0,0 +1,146 @@
+package gexec
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/onsi/gomega/format"
+)
+
+/*
+Group supervises a set of sessions that are expected to keep running - typically the services an integration test
+depends on - and reports promptly when one of them dies:
+
+	group := gexec.NewGroup()
+	group.Add("database", databaseSession)
+	group.Add("api", apiSession)
+
+	Eventually(client.Healthy).WithContext(group.Context()).Should(BeTrue())
+	...
+	Expect(group.Err()).ShouldNot(HaveOccurred())
+	group.TerminateAll()
+
+As soon as any member exits before TerminateAll or KillAll is called, the group records a crash: Crashed is closed,
+Context is cancelled and Err returns an error naming the member, its exit code and the tail of its stderr.  Only the
+first crash is recorded.
+
+Passing the group's Context to an Eventually or Consistently makes the assertion fail at once when a member crashes,
+instead of waiting out its whole timeout for a condition that can no longer come true.  The group does not call
+Gomega's fail handler itself - doing so from a background goroutine would not fail the running test reliably.
+*/
+type Group struct {
+	lock        *sync.Mutex
+	members     []groupMember
+	tearingDown bool
+	crashErr    error
+	crashed     chan struct{}
+	ctx         context.Context
+	cancel      context.CancelCauseFunc
+}
+
+type groupMember struct {
+	name    string
+	session *Session
+}
+
+/*
+NewGroup returns an empty Group.
+*/
+func NewGroup() *Group {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	return &Group{
+		lock:    &sync.Mutex{},
+		crashed: make(chan struct{}),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+/*
+Add starts supervising session under the passed-in name, which is used in error messages.  Add returns the group,
+making it possible to chain.
+*/
+func (g *Group) Add(name string, session *Session) *Group {
+	g.lock.Lock()
+	g.members = append(g.members, groupMember{name: name, session: session})
+	g.lock.Unlock()
+
+	go g.watch(name, session)
+	return g
+}
+
+func (g *Group) watch(name string, session *Session) {
+	<-session.Exited
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if g.tearingDown || g.crashErr != nil {
+		return
+	}
+
+	g.crashErr = fmt.Errorf("%s exited unexpectedly with code %d\nStderr (last %d lines):\n%s",
+		name, session.ExitCode(), OutputTailLines, format.IndentString(string(tail(session.Err.Contents(), OutputTailLines)), 1))
+	close(g.crashed)
+	g.cancel(g.crashErr)
+}
+
+/*
+Crashed returns a channel that is closed when a member exits before the group is torn down.
+*/
+func (g *Group) Crashed() <-chan struct{} {
+	return g.crashed
+}
+
+/*
+Context returns a context that is cancelled when a member exits before the group is torn down.  context.Cause of the
+cancelled context is the same error Err returns.
+*/
+func (g *Group) Context() context.Context {
+	return g.ctx
+}
+
+/*
+Err describes the first member that exited before the group was torn down, or returns nil if none has.
+*/
+func (g *Group) Err() error {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	return g.crashErr
+}
+
+/*
+TerminateAll tears the group down: it sends each member a SIGTERM signal and waits for it to exit, one at a time,
+in the reverse of the order they were added - so services are stopped before the dependencies they were started after.
+Members exiting during teardown are not treated as crashes.
+
+TerminateAll accepts the same timeout/polling intervals as Session.Wait and waits for each member in turn.
+*/
+func (g *Group) TerminateAll(timeout ...interface{}) {
+	for _, session := range g.beginTeardown() {
+		session.Terminate().Wait(timeout...)
+	}
+}
+
+/*
+KillAll is like TerminateAll but sends SIGKILL signals.
+*/
+func (g *Group) KillAll(timeout ...interface{}) {
+	for _, session := range g.beginTeardown() {
+		session.Kill().Wait(timeout...)
+	}
+}
+
+// beginTeardown stops crash detection and returns the members' sessions in reverse order.
+func (g *Group) beginTeardown() []*Session {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.tearingDown = true
+	sessions := make([]*Session, 0, len(g.members))
+	for i := len(g.members) - 1; i >= 0; i-- {
+		sessions = append(sessions, g.members[i].session)
+	}
+	return sessions
+}
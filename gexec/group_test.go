@@ -0,0 +1,88 @@
+// +build !windows
+
+package gexec_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Group", func() {
+	var group *Group
+
+	BeforeEach(func() {
+		group = NewGroup()
+	})
+
+	AfterEach(func() {
+		group.KillAll()
+	})
+
+	It("should surface a member crash promptly, with its stderr tail", func() {
+		group.Add("database", startScript("exec sleep 10"))
+		group.Add("worker", startScript("sleep 0.1; echo 'panic: boom' >&2; exit 2"))
+
+		t := time.Now()
+		failures := InterceptGomegaFailures(func() {
+			Eventually(func() bool { return false }, 10).WithContext(group.Context()).Should(BeTrue())
+		})
+		Expect(time.Since(t)).Should(BeNumerically("<", 2*time.Second))
+		Expect(failures).Should(HaveLen(1))
+		Expect(failures[0]).Should(ContainSubstring("Context was cancelled"))
+		Expect(failures[0]).Should(ContainSubstring("worker exited unexpectedly"))
+		Expect(failures[0]).Should(ContainSubstring("panic: boom"))
+
+		Expect(group.Crashed()).Should(BeClosed())
+		Expect(group.Err()).Should(MatchError(ContainSubstring("worker exited unexpectedly with code 2\nStderr (last")))
+		Expect(group.Err().Error()).Should(ContainSubstring("panic: boom"))
+	})
+
+	It("should only record the first crash", func() {
+		group.Add("first", startScript("exit 3"))
+		Eventually(group.Crashed()).Should(BeClosed())
+		group.Add("second", startScript("exit 4"))
+		time.Sleep(100 * time.Millisecond)
+
+		Expect(group.Err()).Should(MatchError(HavePrefix("first exited unexpectedly with code 3")))
+	})
+
+	It("should not treat exits during teardown as crashes, and tear down in reverse order", func() {
+		dir, err := ioutil.TempDir("", "group")
+		Expect(err).ShouldNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		order := filepath.Join(dir, "order")
+
+		member := func(name string) *Session {
+			session := startScript("trap 'echo " + name + " >> " + order + "; exit 0' TERM; echo ready; while true; do sleep 0.05; done")
+			Eventually(session).Should(Say("ready"))
+			return session
+		}
+		group.Add("database", member("database")).Add("cache", member("cache")).Add("api", member("api"))
+
+		Consistently(group.Crashed(), 0.2).ShouldNot(BeClosed())
+		group.TerminateAll()
+
+		Expect(group.Err()).ShouldNot(HaveOccurred())
+		Expect(group.Context().Err()).ShouldNot(HaveOccurred())
+		contents, err := ioutil.ReadFile(order)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(contents)).Should(Equal("api\ncache\ndatabase\n"))
+	})
+
+	It("should kill every member with KillAll", func() {
+		a, b := startScript("exec sleep 10"), startScript("exec sleep 10")
+		group.Add("a", a).Add("b", b)
+
+		group.KillAll()
+		Expect(a.KilledForcefully()).Should(BeTrue())
+		Expect(b.KilledForcefully()).Should(BeTrue())
+		Expect(group.Err()).ShouldNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,82 @@
+package gexec
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+/*
+TransformLines installs a function that rewrites each line of the command's stdout and stderr before it reaches the
+session's buffers and the outWriter and errWriter passed to Start.  Use it to keep secrets out of captured output:
+
+	token := regexp.MustCompile(`token=\S+`)
+	session, err := gexec.Start(command, GinkgoWriter, GinkgoWriter, gexec.TransformLines(func(line string) string {
+		return token.ReplaceAllString(line, "token=***")
+	}))
+
+transform is called with one complete line at a time, without its trailing newline, so a secret is never split across
+two calls.  Output is held back until its newline arrives; a final line without a newline is transformed and passed on
+once the command exits, before the session's buffers are closed.  Passing TransformLines more than once applies the
+transforms in order.
+*/
+func TransformLines(transform func(line string) string) StartOption {
+	return func(config *startConfig) {
+		config.lineTransforms = append(config.lineTransforms, transform)
+	}
+}
+
+type lineTransformer struct {
+	writer     io.Writer
+	transforms []func(string) string
+	lock       *sync.Mutex
+	partial    []byte
+}
+
+func newLineTransformer(writer io.Writer, transforms []func(string) string) *lineTransformer {
+	return &lineTransformer{
+		writer:     writer,
+		transforms: transforms,
+		lock:       &sync.Mutex{},
+	}
+}
+
+func (t *lineTransformer) Write(p []byte) (int, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.partial = append(t.partial, p...)
+	for {
+		i := bytes.IndexByte(t.partial, '\n')
+		if i < 0 {
+			break
+		}
+		line := t.transform(string(t.partial[:i])) + "\n"
+		t.partial = t.partial[i+1:]
+		if _, err := io.WriteString(t.writer, line); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(p), nil
+}
+
+func (t *lineTransformer) Flush() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if len(t.partial) == 0 {
+		return nil
+	}
+	line := t.transform(string(t.partial))
+	t.partial = nil
+	_, err := io.WriteString(t.writer, line)
+	return err
+}
+
+func (t *lineTransformer) transform(line string) string {
+	for _, transform := range t.transforms {
+		line = transform(line)
+	}
+	return line
+}
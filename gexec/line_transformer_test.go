@@ -0,0 +1,53 @@
+// +build !windows
+
+package gexec_test
+
+import (
+	"os/exec"
+	"regexp"
+	"strings"
+
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TransformLines", func() {
+	token := regexp.MustCompile(`token=\S+`)
+	redact := func(line string) string {
+		return token.ReplaceAllString(line, "token=***")
+	}
+
+	It("should redact a secret split across writes in both the buffers and the writers", func() {
+		script := `printf 'auth token=s3'; sleep 0.1; printf 'cr3t ok\nnext\n'; printf 'err token=abc\n' >&2; printf 'tail token=xyz'`
+		outWriter, errWriter := NewBuffer(), NewBuffer()
+		session, err := Start(exec.Command("sh", "-c", script), outWriter, errWriter, TransformLines(redact))
+		Expect(err).ShouldNot(HaveOccurred())
+		Eventually(session).Should(Exit(0))
+
+		Expect(string(session.Out.Contents())).Should(Equal("auth token=*** ok\nnext\ntail token=***"))
+		Expect(string(session.Err.Contents())).Should(Equal("err token=***\n"))
+		Expect(string(outWriter.Contents())).Should(Equal("auth token=*** ok\nnext\ntail token=***"))
+		Expect(string(errWriter.Contents())).Should(Equal("err token=***\n"))
+		Expect(string(session.Out.Contents())).ShouldNot(ContainSubstring("s3"))
+	})
+
+	It("should hold back output until a newline arrives", func() {
+		session, err := Start(exec.Command("sh", "-c", `printf 'token=secret'; sleep 0.2; printf '\n'`), nil, nil, TransformLines(redact))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Consistently(session.Out, 0.1).ShouldNot(Say("token"))
+		Eventually(session.Out).Should(Say(`token=\*\*\*\n`))
+		Eventually(session).Should(Exit(0))
+	})
+
+	It("should apply multiple transforms in order", func() {
+		session, err := Start(exec.Command("echo", "token=secret"), nil, nil, TransformLines(redact), TransformLines(strings.ToUpper))
+		Expect(err).ShouldNot(HaveOccurred())
+		Eventually(session).Should(Exit(0))
+
+		Expect(string(session.Out.Contents())).Should(Equal("TOKEN=***\n"))
+	})
+})
@@ -0,0 +1,130 @@
+package gexec
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+)
+
+/*
+ForEachLine returns a LineValidator that calls validate with each complete line written to it, as it arrives.  Pass it
+to Start as the outWriter (or errWriter) to check a condition over a command's entire output without keeping the
+output around:
+
+	validator := gexec.ForEachLine(func(line []byte) error {
+		if !json.Valid(line) {
+			return errors.New("not valid JSON")
+		}
+		return nil
+	})
+	session, err := gexec.Start(command, validator, GinkgoWriter)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	Eventually(session).Should(gexec.Exit(0))
+	Expect(validator.Err()).ShouldNot(HaveOccurred())
+
+validate is passed each line without its trailing newline.  Only the current, incomplete line is held in memory.
+If the command's output does not end in a newline, the final partial line is validated too, once the command exits -
+Start flushes the outWriter and errWriter it is passed when the command exits, before the session's buffers are closed.
+
+After validate returns its first error, the LineValidator stops calling it and Err reports that error.  Note that the
+session's Out and Err buffers still capture everything; ForEachLine bounds the memory used by the validation, not by
+the session.
+*/
+func ForEachLine(validate func(line []byte) error) *LineValidator {
+	return &LineValidator{
+		validate: validate,
+		lock:     &sync.Mutex{},
+	}
+}
+
+/*
+LineValidator is an io.Writer that validates its input line by line.  Create one with ForEachLine.
+*/
+type LineValidator struct {
+	validate func([]byte) error
+	lock     *sync.Mutex
+	partial  []byte
+	lines    int
+	err      error
+}
+
+func (v *LineValidator) Write(p []byte) (int, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if v.err != nil {
+		return len(p), nil
+	}
+
+	data := p
+	if len(v.partial) > 0 {
+		data = append(v.partial, p...)
+		v.partial = nil
+	}
+
+	for {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			break
+		}
+		if !v.check(data[:i]) {
+			return len(p), nil
+		}
+		data = data[i+1:]
+	}
+	v.partial = append(v.partial, data...)
+
+	return len(p), nil
+}
+
+/*
+Flush validates the final partial line, if there is one.  Start calls it when the command exits.
+*/
+func (v *LineValidator) Flush() error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if v.err == nil && len(v.partial) > 0 {
+		v.check(v.partial)
+	}
+	v.partial = nil
+	return nil
+}
+
+/*
+Err returns the error from the first line that failed validation, annotated with its line number, or nil if every line
+so far was valid.
+*/
+func (v *LineValidator) Err() error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.err
+}
+
+/*
+Lines returns the number of lines validated so far.
+*/
+func (v *LineValidator) Lines() int {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.lines
+}
+
+func (v *LineValidator) check(line []byte) bool {
+	v.lines++
+	if err := v.validate(line); err != nil {
+		v.err = fmt.Errorf("line %d failed validation: %s\n%s", v.lines, err, truncatedLine(line))
+		return false
+	}
+	return true
+}
+
+const maxReportedLineLength = 200
+
+func truncatedLine(line []byte) string {
+	if len(line) > maxReportedLineLength {
+		return fmt.Sprintf("%q...", line[:maxReportedLineLength])
+	}
+	return fmt.Sprintf("%q", line)
+}
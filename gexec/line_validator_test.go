@@ -0,0 +1,69 @@
+// +build !windows
+
+package gexec_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os/exec"
+
+	. "github.com/onsi/gomega/gexec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ForEachLine", func() {
+	var validated [][]byte
+	var validator *LineValidator
+
+	BeforeEach(func() {
+		validated = nil
+		validator = ForEachLine(func(line []byte) error {
+			validated = append(validated, append([]byte{}, line...))
+			if !json.Valid(line) {
+				return errors.New("not valid JSON")
+			}
+			return nil
+		})
+	})
+
+	It("should validate each line of a session's output and report the first failure", func() {
+		script := `i=1; while [ $i -le 2000 ]; do if [ $i = 1234 ]; then echo 'not json'; else echo "{\"n\":$i}"; fi; i=$((i+1)); done`
+		session, err := Start(exec.Command("sh", "-c", script), validator, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Eventually(session, 10).Should(Exit(0))
+
+		Expect(validator.Err()).Should(MatchError("line 1234 failed validation: not valid JSON\n\"not json\""))
+		Expect(validator.Lines()).Should(Equal(1234))
+		Expect(validated).Should(HaveLen(1234))
+	})
+
+	It("should succeed when every line is valid", func() {
+		session, err := Start(exec.Command("sh", "-c", `echo '{"a":1}'; echo '[1,2]'`), validator, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Eventually(session).Should(Exit(0))
+
+		Expect(validator.Err()).ShouldNot(HaveOccurred())
+		Expect(validator.Lines()).Should(Equal(2))
+	})
+
+	It("should validate lines split across writes", func() {
+		validator.Write([]byte(`{"a"`))
+		Expect(validated).Should(BeEmpty())
+		validator.Write([]byte(":1}\n[1,"))
+		validator.Write([]byte("2]\n"))
+
+		Expect(validated).Should(Equal([][]byte{[]byte(`{"a":1}`), []byte("[1,2]")}))
+		Expect(validator.Err()).ShouldNot(HaveOccurred())
+	})
+
+	It("should validate a final partial line when the session exits", func() {
+		session, err := Start(exec.Command("sh", "-c", `echo '{"a":1}'; printf '{"b":'`), validator, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Eventually(session).Should(Exit(0))
+
+		Expect(validated).Should(HaveLen(2))
+		Expect(validator.Err()).Should(MatchError(ContainSubstring("line 2 failed validation")))
+	})
+})
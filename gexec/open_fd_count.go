@@ -0,0 +1,18 @@
+package gexec
+
+/*
+OpenFDCount returns the number of file descriptors the process with the passed-in pid has open.  Use it to catch
+descriptor leaks in long-running processes:
+
+	Consistently(func() (int, error) {
+		return gexec.OpenFDCount(session.PID())
+	}).Should(BeNumerically("<", 50))
+
+On Linux the count is read from /proc/<pid>/fd.  On macOS and the BSDs it is read from the output of lsof, which must be
+on the PATH.  Other platforms are not supported and OpenFDCount returns an error.
+
+Inspecting another user's process usually requires elevated privileges; OpenFDCount returns an error if it is denied.
+*/
+func OpenFDCount(pid int) (int, error) {
+	return openFDCount(pid)
+}
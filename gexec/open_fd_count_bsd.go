@@ -0,0 +1,29 @@
+// +build darwin freebsd netbsd openbsd dragonfly
+
+package gexec
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strconv"
+)
+
+func openFDCount(pid int) (int, error) {
+	output, err := exec.Command("lsof", "-n", "-P", "-p", strconv.Itoa(pid), "-F", "f").Output()
+	if err != nil {
+		return 0, fmt.Errorf("could not count open file descriptors of process %d with lsof: %s", pid, err)
+	}
+
+	//lsof -F f prints a "p<pid>" line followed by an "f<descriptor>" line per open file.  Entries such as
+	//"fcwd" and "ftxt" are not file descriptors, so only numeric ones are counted.
+	count := 0
+	for _, line := range bytes.Split(output, []byte("\n")) {
+		if len(line) > 1 && line[0] == 'f' {
+			if _, err := strconv.Atoi(string(line[1:])); err == nil {
+				count++
+			}
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,16 @@
+// +build linux
+
+package gexec
+
+import (
+	"fmt"
+	"io/ioutil"
+)
+
+func openFDCount(pid int) (int, error) {
+	entries, err := ioutil.ReadDir(fmt.Sprintf("/proc/%d/fd", pid))
+	if err != nil {
+		return 0, fmt.Errorf("could not count open file descriptors of process %d: %s", pid, err)
+	}
+	return len(entries), nil
+}
@@ -0,0 +1,40 @@
+// +build linux
+
+package gexec_test
+
+import (
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("OpenFDCount", func() {
+	It("should count the descriptors a process has open", func() {
+		session, err := Start(exec.Command("sh", "-c", "exec 3</dev/null 4</dev/null 5</dev/null; echo ready; exec sleep 10"), nil, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer func() {
+			session.Kill().Wait()
+		}()
+		Eventually(session).Should(Say("ready"))
+
+		count, err := OpenFDCount(session.PID())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(count).Should(And(BeNumerically(">=", 6), BeNumerically("<", 50)))
+
+		Consistently(func() (int, error) {
+			return OpenFDCount(session.PID())
+		}, 0.2).Should(Equal(count))
+	})
+
+	It("should error for a process that does not exist", func() {
+		session, err := Start(exec.Command("true"), nil, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		session.Wait()
+
+		_, err = OpenFDCount(session.PID())
+		Expect(err).Should(MatchError(ContainSubstring("could not count open file descriptors of process")))
+	})
+})
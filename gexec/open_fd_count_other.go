@@ -0,0 +1,12 @@
+// +build !linux,!darwin,!freebsd,!netbsd,!openbsd,!dragonfly
+
+package gexec
+
+import (
+	"fmt"
+	"runtime"
+)
+
+func openFDCount(pid int) (int, error) {
+	return 0, fmt.Errorf("gexec.OpenFDCount is not supported on %s", runtime.GOOS)
+}
@@ -0,0 +1,41 @@
+package gexec
+
+import (
+	"fmt"
+	"net"
+)
+
+/*
+PortFree returns a function that succeeds (returns nil) once the passed-in address is no longer bound, i.e. once it
+is possible to listen on it again.  It pairs with Eventually to assert that a process releases its port after shutting down:
+
+	session.Terminate().Wait()
+	Eventually(gexec.PortFree("tcp", "127.0.0.1:8080")).Should(Succeed())
+
+The network is any network supported by net.Listen ("tcp", "tcp4", "tcp6", "unix") or net.ListenPacket ("udp", "udp4", "udp6", "unixgram").
+PortFree probes by briefly binding the address and immediately releasing it.
+
+A note on TIME_WAIT: on Unix, Go's net.Listen sets SO_REUSEADDR, so TCP connections lingering in TIME_WAIT after the
+process exits do not prevent the port from being reported free.  Other platforms may keep reporting the port as bound
+until those connections expire - give Eventually a generous timeout there, or expect some flakiness.
+*/
+func PortFree(network, address string) func() error {
+	return func() error {
+		var err error
+		switch network {
+		case "udp", "udp4", "udp6", "unixgram":
+			var conn net.PacketConn
+			conn, err = net.ListenPacket(network, address)
+			if err == nil {
+				return conn.Close()
+			}
+		default:
+			var listener net.Listener
+			listener, err = net.Listen(network, address)
+			if err == nil {
+				return listener.Close()
+			}
+		}
+		return fmt.Errorf("%s address %s is not free: %s", network, address, err)
+	}
+}
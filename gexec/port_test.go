@@ -0,0 +1,60 @@
+package gexec_test
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("PortFree", func() {
+	Context("for tcp", func() {
+		It("succeeds once the port is released", func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			address := listener.Addr().String()
+
+			err = gexec.PortFree("tcp", address)()
+			Expect(err).To(MatchError(ContainSubstring("tcp address " + address + " is not free")))
+
+			Expect(listener.Close()).To(Succeed())
+			Eventually(gexec.PortFree("tcp", address)).Should(Succeed())
+		})
+
+		It("succeeds after an accepted connection is closed", func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			address := listener.Addr().String()
+
+			accepted := make(chan error, 1)
+			go func() {
+				conn, err := listener.Accept()
+				if err == nil {
+					conn.Close()
+				}
+				accepted <- err
+			}()
+			conn, err := net.Dial("tcp", address)
+			Expect(err).NotTo(HaveOccurred())
+			conn.Close()
+			Eventually(accepted).Should(Receive(BeNil()))
+
+			Expect(listener.Close()).To(Succeed())
+			Eventually(gexec.PortFree("tcp", address)).Should(Succeed())
+		})
+	})
+
+	Context("for udp", func() {
+		It("succeeds once the port is released", func() {
+			conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			address := conn.LocalAddr().String()
+
+			Expect(gexec.PortFree("udp", address)()).NotTo(Succeed())
+
+			Expect(conn.Close()).To(Succeed())
+			Eventually(gexec.PortFree("udp", address)).Should(Succeed())
+		})
+	})
+})
@@ -0,0 +1,27 @@
+// +build !windows
+
+package gexec
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+func setProcessGroup(command *exec.Cmd) {
+	if command.SysProcAttr == nil {
+		command.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	command.SysProcAttr.Setpgid = true
+}
+
+func killProcessGroup(command *exec.Cmd) {
+	if command.Process == nil {
+		return
+	}
+	syscall.Kill(-command.Process.Pid, syscall.SIGKILL)
+}
+
+func processExists(pid int) bool {
+	err := syscall.Kill(pid, 0)
+	return err == nil || err == syscall.EPERM
+}
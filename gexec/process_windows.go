@@ -0,0 +1,25 @@
+// +build windows
+
+package gexec
+
+import (
+	"os/exec"
+	"strconv"
+)
+
+func setProcessGroup(command *exec.Cmd) {}
+
+func killProcessGroup(command *exec.Cmd) {
+	if command.Process == nil {
+		return
+	}
+	//Windows has no process groups: taskkill /T kills the process along with the tree of processes it started
+	err := exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(command.Process.Pid)).Run()
+	if err != nil {
+		command.Process.Kill()
+	}
+}
+
+func processExists(pid int) bool {
+	return true
+}
@@ -7,11 +7,15 @@ Package gexec provides support for testing external processes.
 package gexec
 
 import (
+	"errors"
+	"fmt"
 	"io"
 	"os"
 	"os/exec"
+	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
@@ -32,8 +36,21 @@ type Session struct {
 	//A channel that will close when the command exits
 	Exited <-chan struct{}
 
-	lock     *sync.Mutex
-	exitCode int
+	lock           *sync.Mutex
+	exitCode       int
+	signal         os.Signal
+	defaultTimeout time.Duration
+	stdin          io.WriteCloser
+	pid            int
+	startTime      time.Time
+	endTime        time.Time
+	waitErr        error
+	flushers       []flusher
+}
+
+//flusher is implemented by writers that hold back partial output; they are flushed once the command exits.
+type flusher interface {
+	Flush() error
 }
 
 /*
@@ -62,9 +79,16 @@ Instead, to assert that the command has exited you can use the gexec.Exit matche
 	Expect(session).Should(gexec.Exit())
 
 When the session exits it closes the stdout and stderr gbytes buffers.  This will short circuit any
-Eventuallys waiting for the buffers to Say something.
+Eventuallys waiting for the buffers to Say something.  Just before that, outWriter and errWriter are flushed if they
+have a `Flush() error` method, so that writers which hold back partial lines (such as TestLogWriter and LineValidator)
+see the command's final output.
+
+Start can be passed StartOptions to further configure the session, for example:
+
+	session, err := Start(command, GinkgoWriter, GinkgoWriter, StripANSIFromBuffers())
 */
-func Start(command *exec.Cmd, outWriter io.Writer, errWriter io.Writer) (*Session, error) {
+func Start(command *exec.Cmd, outWriter io.Writer, errWriter io.Writer, options ...StartOption) (*Session, error) {
+	config := newStartConfig(options)
 	exited := make(chan struct{})
 
 	session := &Session{
@@ -79,6 +103,9 @@ func Start(command *exec.Cmd, outWriter io.Writer, errWriter io.Writer) (*Sessio
 	var commandOut, commandErr io.Writer
 
 	commandOut, commandErr = session.Out, session.Err
+	if config.stripANSI {
+		commandOut, commandErr = StripANSI(commandOut), StripANSI(commandErr)
+	}
 
 	if outWriter != nil {
 		commandOut = io.MultiWriter(commandOut, outWriter)
@@ -88,11 +115,42 @@ func Start(command *exec.Cmd, outWriter io.Writer, errWriter io.Writer) (*Sessio
 		commandErr = io.MultiWriter(commandErr, errWriter)
 	}
 
+	if config.onFirstStderrWrite != nil {
+		commandErr = io.MultiWriter(commandErr, &firstWriteNotifier{callback: config.onFirstStderrWrite})
+	}
+
+	if len(config.lineTransforms) > 0 {
+		outTransformer := newLineTransformer(commandOut, config.lineTransforms)
+		errTransformer := newLineTransformer(commandErr, config.lineTransforms)
+		session.flushers = append(session.flushers, outTransformer, errTransformer)
+		commandOut, commandErr = outTransformer, errTransformer
+	}
+
+	for _, writer := range []io.Writer{outWriter, errWriter} {
+		if f, ok := writer.(flusher); ok {
+			session.flushers = append(session.flushers, f)
+		}
+	}
+
 	command.Stdout = commandOut
 	command.Stderr = commandErr
 
+	if config.stdin {
+		stdin, err := command.StdinPipe()
+		if err != nil {
+			return session, err
+		}
+		session.stdin = stdin
+	}
+
+	startTime := time.Now()
 	err := command.Start()
 	if err == nil {
+		session.lock.Lock()
+		session.pid = command.Process.Pid
+		session.startTime = startTime
+		session.lock.Unlock()
+
 		go session.monitorForExit(exited)
 		trackedSessionsMutex.Lock()
 		defer trackedSessionsMutex.Unlock()
@@ -102,6 +160,39 @@ func Start(command *exec.Cmd, outWriter io.Writer, errWriter io.Writer) (*Sessio
 	return session, err
 }
 
+/*
+WriteLine formats according to the format specifier, appends a newline, and writes the result to the command's stdin.
+It is the counterpart to Say for driving interactive programs:
+
+	session, err := gexec.Start(exec.Command(pathToREPL), GinkgoWriter, GinkgoWriter, gexec.WithStdin())
+	Expect(err).ShouldNot(HaveOccurred())
+
+	Expect(session.WriteLine("1 + %d", 2)).Should(Succeed())
+	Eventually(session).Should(gbytes.Say("3"))
+
+The command's stdin is a pipe, so nothing is buffered: the line is available to the command as soon as WriteLine returns.
+WriteLine returns an error if the session was not started with the WithStdin option, or if stdin has been closed.
+*/
+func (s *Session) WriteLine(format string, args ...interface{}) error {
+	if s.stdin == nil {
+		return errors.New("session was started without stdin: pass gexec.WithStdin() to Start to write to it")
+	}
+	_, err := fmt.Fprintf(s.stdin, format+"\n", args...)
+	return err
+}
+
+/*
+CloseStdin closes the command's stdin, signalling end of input.  Many interactive programs exit when their input ends.
+
+CloseStdin returns an error if the session was not started with the WithStdin option.
+*/
+func (s *Session) CloseStdin() error {
+	if s.stdin == nil {
+		return errors.New("session was started without stdin: pass gexec.WithStdin() to Start to write to it")
+	}
+	return s.stdin.Close()
+}
+
 /*
 Buffer implements the gbytes.BufferProvider interface and returns s.Out
 This allows you to make gbytes.Say matcher assertions against stdout without having to reference .Out:
@@ -121,7 +212,8 @@ To assert that the command has exited it is more convenient to use the Exit matc
 
 When the process exits because it has received a particular signal, the exit code will be 128+signal-value
 (See http://www.tldp.org/LDP/abs/html/exitcodes.html and http://man7.org/linux/man-pages/man7/signal.7.html)
-
+on every Unix, matching what a shell reports.  Use Signaled to get the signal itself.  On Windows there are no signals,
+and ExitCode is always the code the process exited with.
 */
 func (s *Session) ExitCode() int {
 	s.lock.Lock()
@@ -129,6 +221,165 @@ func (s *Session) ExitCode() int {
 	return s.exitCode
 }
 
+/*
+KilledForcefully returns true if the wrapped command was ended by SIGKILL - whether sent by Kill, by the package-level Kill
+and KillAndWait, or by something outside the test.  It returns false while the command is running and if the command
+exited on its own or in response to a catchable signal such as SIGTERM.
+
+This makes it possible to assert that shutdown escalation had to resort to force:
+
+	session.Terminate()
+	Consistently(session).ShouldNot(Exit())
+	session.Kill().Wait()
+	Expect(session.KilledForcefully()).Should(BeTrue())
+
+Windows has no signals, so there KilledForcefully always returns false.
+*/
+func (s *Session) KilledForcefully() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.signal == syscall.SIGKILL
+}
+
+/*
+Signaled reports the signal that terminated the wrapped command.  It returns false while the command is running, if the
+command exited on its own (including exiting from a signal handler it installed), and wherever signal information is not
+available.  When Signaled returns true, ExitCode returns 128 plus the signal's number:
+
+	session.Terminate().Wait()
+	signal, ok := session.Signaled()
+	Expect(ok).Should(BeTrue())
+	Expect(signal).Should(Equal(syscall.SIGTERM))
+	Expect(session.ExitCode()).Should(Equal(128 + int(syscall.SIGTERM)))
+
+Windows has no signals: a process stopped with Kill reports its exit code (normally 1) and Signaled always returns false.
+*/
+func (s *Session) Signaled() (os.Signal, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.signal, s.signal != nil
+}
+
+/*
+PID returns the process id of the wrapped command.  If the command failed to start, PID returns 0.
+
+The PID remains available after the command exits, though the operating system may since have reused it for another process.
+*/
+func (s *Session) PID() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.pid
+}
+
+/*
+Alive returns true if the wrapped command has started and has not yet exited.
+
+On Unix, Alive additionally probes the process with a (cheap) signal 0 to confirm it still exists.
+*/
+func (s *Session) Alive() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.pid != 0 && s.exitCode == -1 && processExists(s.pid)
+}
+
+/*
+RunDuration returns how long the wrapped command ran for, measured from just before it was started until its exit was observed.
+The returned bool is true once the command has exited.
+
+While the command is still running, RunDuration returns the time elapsed so far and false.  If the command failed to start, RunDuration returns 0 and false.
+*/
+func (s *Session) RunDuration() (time.Duration, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.startTime.IsZero() {
+		return 0, false
+	}
+	if s.endTime.IsZero() {
+		return time.Since(s.startTime), false
+	}
+	return s.endTime.Sub(s.startTime), true
+}
+
+/*
+ExitInfo summarizes how a session's command ended.  See Session.ExitInfo.
+*/
+type ExitInfo struct {
+	//Code is the exit code, as returned by ExitCode
+	Code int
+
+	//Signal is the signal that terminated the command, and Signaled is true if there was one, as returned by Signaled
+	Signal   os.Signal
+	Signaled bool
+
+	//Duration is how long the command ran for, as returned by RunDuration
+	Duration time.Duration
+
+	//Err is the error returned when waiting for the command.  It is an *exec.ExitError if the command exited with a non-zero
+	//code or was terminated by a signal, and nil if it exited cleanly.
+	Err error
+}
+
+/*
+ExitInfo returns a summary of how the wrapped command ended, bundling the values of ExitCode, Signaled and RunDuration
+into one value that is convenient to attach to test reports:
+
+	info, exited := session.ExitInfo()
+	fmt.Fprintf(GinkgoWriter, "exited with code %d after %s\n", info.Code, info.Duration)
+
+The returned bool is false, and the ExitInfo is empty, while the command is still running or if it failed to start.
+*/
+func (s *Session) ExitInfo() (ExitInfo, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.endTime.IsZero() {
+		return ExitInfo{}, false
+	}
+
+	return ExitInfo{
+		Code:     s.exitCode,
+		Signal:   s.signal,
+		Signaled: s.signal != nil,
+		Duration: s.endTime.Sub(s.startTime),
+		Err:      s.waitErr,
+	}, true
+}
+
+/*
+ShouldExitWithin asserts that the wrapped command exits after running for at least min and at most max.
+It waits (up to max after the command was started) for the command to exit and triggers a test failure if the command
+is still running, exited too early, or exited too late.
+
+This is useful for asserting that a process respects a deadline it was given:
+
+	session, err := gexec.Start(exec.Command(cliPath, "--timeout=1s"), GinkgoWriter, GinkgoWriter)
+	Expect(err).ShouldNot(HaveOccurred())
+	session.ShouldExitWithin(900*time.Millisecond, 1500*time.Millisecond)
+
+ShouldExitWithin returns the session, making it possible to chain.
+*/
+func (s *Session) ShouldExitWithin(min, max time.Duration) *Session {
+	s.lock.Lock()
+	deadline := s.startTime.Add(max)
+	s.lock.Unlock()
+
+	select {
+	case <-s.Exited:
+	case <-time.After(time.Until(deadline)):
+	}
+
+	duration, exited := s.RunDuration()
+	if !exited {
+		ExpectWithOffset(1, s).Should(Exit(), "Expected process to exit within %s", max)
+		return s
+	}
+
+	ExpectWithOffset(1, duration).Should(BeNumerically(">=", min), "Expected process to run for at least %s before exiting", min)
+	ExpectWithOffset(1, duration).Should(BeNumerically("<=", max), "Expected process to exit within %s", max)
+	return s
+}
+
 /*
 Wait waits until the wrapped command exits.  It can be passed an optional timeout.
 If the command does not exit within the timeout, Wait will trigger a test failure.
@@ -140,12 +391,75 @@ Wait returns the session, making it possible to chain:
 will wait for the command to exit then return the entirety of Out's contents.
 
 Wait uses eventually under the hood and accepts the same timeout/polling intervals that eventually does.
+If no timeout is passed in, Wait uses the session's default timeout (see WithDefaultTimeout).
 */
 func (s *Session) Wait(timeout ...interface{}) *Session {
-	EventuallyWithOffset(1, s, timeout...).Should(Exit())
+	EventuallyWithOffset(1, s, s.intervals(timeout)...).Should(Exit())
+	return s
+}
+
+/*
+WithDefaultTimeout sets the timeout that gexec's waiting helpers - Wait, AssertExit, and the package-level KillAndWait
+and TerminateAndWait - use for this session when they are not passed one explicitly.  This keeps the timing of a slow
+subprocess local to its session, without changing Gomega's global Eventually defaults:
+
+	session, err := gexec.Start(command, GinkgoWriter, GinkgoWriter)
+	Expect(err).ShouldNot(HaveOccurred())
+	session.WithDefaultTimeout(30 * time.Second)
+
+	session.Wait()                // waits up to 30 seconds
+	session.Wait(time.Minute)     // an explicit timeout still wins
+
+A zero duration restores Gomega's default Eventually timeout.  WithDefaultTimeout returns the session, making it possible to chain.
+*/
+func (s *Session) WithDefaultTimeout(timeout time.Duration) *Session {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.defaultTimeout = timeout
 	return s
 }
 
+//intervals returns the passed-in timeout/polling intervals, falling back to the session's default timeout if there are none
+func (s *Session) intervals(intervals []interface{}) []interface{} {
+	if len(intervals) > 0 {
+		return intervals
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.defaultTimeout > 0 {
+		return []interface{}{s.defaultTimeout}
+	}
+	return nil
+}
+
+/*
+Complete waits up to timeout for the wrapped command to exit and then returns everything it wrote to stdout and stderr along with its exit code.
+It replaces the common Eventually(session).Should(Exit()) then read-the-buffers dance with a single call:
+
+	stdout, stderr, code, err := session.Complete(5 * time.Second)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(code).Should(Equal(0))
+
+If the command does not exit in time, Complete returns the output so far, an exit code of -1, and an error.  The command is left running:
+it is up to the caller to Kill or Terminate it.
+
+Unlike Wait, Complete does not trigger a test failure.
+*/
+func (s *Session) Complete(timeout time.Duration) (stdout, stderr string, code int, err error) {
+	if s.PID() == 0 {
+		return "", "", -1, fmt.Errorf("%s was never started", strings.Join(s.Command.Args, " "))
+	}
+
+	select {
+	case <-s.Exited:
+	case <-time.After(timeout):
+		err = fmt.Errorf("%s did not exit within %s and is still running (pid %d)", strings.Join(s.Command.Args, " "), timeout, s.PID())
+	}
+
+	return string(s.Out.Contents()), string(s.Err.Contents()), s.ExitCode(), err
+}
+
 /*
 Kill sends the running command a SIGKILL signal.  It does not wait for the process to exit.
 
@@ -179,6 +493,40 @@ func (s *Session) Terminate() *Session {
 	return s.Signal(syscall.SIGTERM)
 }
 
+/*
+TerminateAndExpect verifies a graceful shutdown: it sends the running command a SIGTERM signal, asserts that each
+of the passed-in patterns then appears on Out in order, and finally asserts that the command exits:
+
+	session.TerminateAndExpect("draining connections", "stopped")
+
+Patterns are regular expressions, as with gbytes.Say, and each one is matched after the previous one in Out's read cursor.
+Each step waits up to the session's default timeout (see WithDefaultTimeout).  The failure message says whether the command
+did not log the expected sequence or logged it but did not exit, and includes the tail of the command's output.
+Any exit code is accepted - check ExitCode() afterwards if it matters.
+
+The session is returned to enable chaining.
+*/
+func (s *Session) TerminateAndExpect(patterns ...string) *Session {
+	s.Terminate()
+
+	intervals := s.intervals(nil)
+	outputTail := OutputTail(s)
+	for i, pattern := range patterns {
+		description := func() string {
+			return fmt.Sprintf("The command did not log the expected shutdown sequence after SIGTERM: step %d of %d (%q) did not appear.\n%s", i+1, len(patterns), pattern, outputTail())
+		}
+		if !EventuallyWithOffset(1, s.Out, intervals...).Should(gbytes.Say(pattern), description) {
+			return s
+		}
+	}
+
+	EventuallyWithOffset(1, s, intervals...).Should(Exit(), func() string {
+		return fmt.Sprintf("The command logged the expected shutdown sequence after SIGTERM but did not exit.\n%s", outputTail())
+	})
+
+	return s
+}
+
 /*
 Signal sends the running command the passed in signal.  It does not wait for the process to exit.
 
@@ -195,24 +543,53 @@ func (s *Session) Signal(signal os.Signal) *Session {
 
 func (s *Session) monitorForExit(exited chan<- struct{}) {
 	err := s.Command.Wait()
+	for _, f := range s.flushers {
+		f.Flush()
+	}
 	s.lock.Lock()
+	s.endTime = time.Now()
 	s.Out.Close()
 	s.Err.Close()
-	status := s.Command.ProcessState.Sys().(syscall.WaitStatus)
-	if status.Signaled() {
-		s.exitCode = 128 + int(status.Signal())
-	} else {
-		exitStatus := status.ExitStatus()
-		if exitStatus == -1 && err != nil {
-			s.exitCode = INVALID_EXIT_CODE
-		}
-		s.exitCode = exitStatus
-	}
+	s.exitCode = getExitCode(s.Command.ProcessState, err)
+	s.signal = terminatingSignal(s.Command.ProcessState)
+	s.waitErr = err
 	s.lock.Unlock()
 
 	close(exited)
 }
 
+func terminatingSignal(state *os.ProcessState) os.Signal {
+	if state == nil {
+		return nil
+	}
+	status, ok := state.Sys().(syscall.WaitStatus)
+	if !ok || !status.Signaled() {
+		return nil
+	}
+	return status.Signal()
+}
+
+//exitCodeFromProcessState extracts the exit code of a finished process.  Processes killed by a signal
+//report 128 plus the signal number, as a shell would.  It is a variable so that tests can simulate exits.
+var exitCodeFromProcessState = func(state *os.ProcessState) int {
+	status := state.Sys().(syscall.WaitStatus)
+	if status.Signaled() {
+		return 128 + int(status.Signal())
+	}
+	return status.ExitStatus()
+}
+
+func getExitCode(state *os.ProcessState, err error) int {
+	if state == nil {
+		return INVALID_EXIT_CODE
+	}
+	exitCode := exitCodeFromProcessState(state)
+	if exitCode == -1 && err != nil {
+		return INVALID_EXIT_CODE
+	}
+	return exitCode
+}
+
 func (s *Session) processIsAlive() bool {
 	return s.ExitCode() == -1 && s.Command.Process != nil
 }
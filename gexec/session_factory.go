@@ -0,0 +1,61 @@
+package gexec
+
+import (
+	"io"
+	"os"
+	"os/exec"
+)
+
+/*
+SessionFactory starts sessions with shared defaults.  This is useful in suites that start many commands with the same
+output writers, working directory and environment:
+
+	var factory = &gexec.SessionFactory{
+		OutWriter: GinkgoWriter,
+		ErrWriter: GinkgoWriter,
+		Dir:       fixturesDir,
+		Env:       []string{"LOG_LEVEL=debug"},
+	}
+
+	session, err := factory.Start(exec.Command(pathToCLI, "serve"))
+
+The defaults only fill in what the command leaves unset, so per-call settings win:
+
+- if command.Dir is set it is used instead of Dir
+- Env is layered on top of the current process's environment, and command.Env is layered on top of that.  When a variable appears more than once, the last value wins.
+- options passed to Start are applied after the factory's Options
+
+Sessions started by a factory are tracked like any other session, so the package-level Kill, KillAndWait, Terminate
+and TerminateAndWait clean them up.
+*/
+type SessionFactory struct {
+	//OutWriter and ErrWriter are passed to Start as the outWriter and errWriter
+	OutWriter io.Writer
+	ErrWriter io.Writer
+
+	//Dir is the working directory for commands that do not set their own
+	Dir string
+
+	//Env holds environment overrides, in "KEY=value" form
+	Env []string
+
+	//Options are applied to every session the factory starts
+	Options []StartOption
+}
+
+/*
+Start applies the factory's defaults to the passed-in command and starts it with Start.
+*/
+func (f *SessionFactory) Start(command *exec.Cmd, options ...StartOption) (*Session, error) {
+	if command.Dir == "" {
+		command.Dir = f.Dir
+	}
+
+	if len(f.Env) > 0 {
+		env := append(os.Environ(), f.Env...)
+		command.Env = append(env, command.Env...)
+	}
+
+	allOptions := append(append([]StartOption{}, f.Options...), options...)
+	return Start(command, f.OutWriter, f.ErrWriter, allOptions...)
+}
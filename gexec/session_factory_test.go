@@ -0,0 +1,85 @@
+// +build !windows
+
+package gexec_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("SessionFactory", func() {
+	var factoryDir, callDir string
+	var outWriter, errWriter *Buffer
+	var factory *SessionFactory
+
+	BeforeEach(func() {
+		var err error
+		factoryDir, err = ioutil.TempDir("", "factory-dir")
+		Expect(err).NotTo(HaveOccurred())
+		factoryDir, err = filepath.EvalSymlinks(factoryDir)
+		Expect(err).NotTo(HaveOccurred())
+		callDir, err = ioutil.TempDir("", "call-dir")
+		Expect(err).NotTo(HaveOccurred())
+		callDir, err = filepath.EvalSymlinks(callDir)
+		Expect(err).NotTo(HaveOccurred())
+
+		outWriter, errWriter = NewBuffer(), NewBuffer()
+		factory = &SessionFactory{
+			OutWriter: outWriter,
+			ErrWriter: errWriter,
+			Dir:       factoryDir,
+			Env:       []string{"FACTORY_VAR=from-factory", "SHARED_VAR=from-factory"},
+			Options:   []StartOption{StripANSIFromBuffers()},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(factoryDir)
+		os.RemoveAll(callDir)
+	})
+
+	script := `pwd; echo "$FACTORY_VAR $SHARED_VAR"; echo "path:$PATH"; printf '\033[31mred\033[0m\n' >&2`
+
+	It("applies its defaults", func() {
+		session, err := factory.Start(exec.Command("sh", "-c", script))
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(session).Should(Exit(0))
+
+		Expect(session).To(Say(factoryDir + "\n"))
+		Expect(session).To(Say("from-factory from-factory\n"))
+		Expect(session).To(Say("path:" + os.Getenv("PATH") + "\n"))
+		Expect(session.Err.Contents()).To(Equal([]byte("red\n")))
+
+		Expect(outWriter).To(Say(factoryDir))
+		Expect(errWriter.Contents()).To(Equal([]byte("\x1b[31mred\x1b[0m\n")))
+	})
+
+	It("lets per-call settings win", func() {
+		command := exec.Command("sh", "-c", script)
+		command.Dir = callDir
+		command.Env = []string{"SHARED_VAR=from-call"}
+
+		session, err := factory.Start(command)
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(session).Should(Exit(0))
+
+		Expect(session).To(Say(callDir + "\n"))
+		Expect(session).To(Say("from-factory from-call\n"))
+	})
+
+	It("applies per-call options", func() {
+		factory.Options = nil
+		session, err := factory.Start(exec.Command("sh", "-c", script), StripANSIFromBuffers())
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(session).Should(Exit(0))
+
+		Expect(session.Err.Contents()).To(Equal([]byte("red\n")))
+	})
+})
@@ -3,9 +3,14 @@
 package gexec_test
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
+	"os"
 	"os/exec"
+	"sync"
 	"syscall"
 	"time"
 
@@ -72,6 +77,120 @@ var _ = Describe("Session", func() {
 		})
 	})
 
+	Describe("providing the pid", func() {
+		It("should provide the process's pid", func() {
+			Expect(session.PID()).Should(BeNumerically(">", 0))
+			Expect(session.PID()).Should(Equal(command.Process.Pid))
+		})
+
+		It("should remain stable across the session's lifetime", func() {
+			pid := session.PID()
+			Eventually(session).Should(Exit())
+			Expect(session.PID()).Should(Equal(pid))
+		})
+
+		It("should be 0 if the command did not start", func() {
+			session, err := Start(exec.Command("notexisting"), GinkgoWriter, GinkgoWriter)
+			Expect(err).To(HaveOccurred())
+			Expect(session.PID()).Should(Equal(0))
+		})
+	})
+
+	Describe("alive", func() {
+		It("should report whether the process is running", func() {
+			session, err := Start(exec.Command("sleep", "10000000"), GinkgoWriter, GinkgoWriter)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(session.Alive()).Should(BeTrue())
+
+			session.Kill()
+			Eventually(session.Alive).Should(BeFalse())
+			Eventually(session).Should(Exit())
+			Expect(session.Alive()).Should(BeFalse())
+		})
+
+		It("should be false if the command did not start", func() {
+			session, err := Start(exec.Command("notexisting"), GinkgoWriter, GinkgoWriter)
+			Expect(err).To(HaveOccurred())
+			Expect(session.Alive()).Should(BeFalse())
+		})
+	})
+
+	Describe("run duration", func() {
+		It("should report the elapsed time while running", func() {
+			session, err := Start(exec.Command("sleep", "10000000"), GinkgoWriter, GinkgoWriter)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			time.Sleep(50 * time.Millisecond)
+			duration, exited := session.RunDuration()
+			Expect(exited).Should(BeFalse())
+			Expect(duration).Should(BeNumerically(">=", 50*time.Millisecond))
+
+			session.Kill().Wait()
+		})
+
+		It("should report how long the command ran for once it exits", func() {
+			session, err := Start(exec.Command("sleep", "0.2"), GinkgoWriter, GinkgoWriter)
+			Expect(err).ShouldNot(HaveOccurred())
+			Eventually(session).Should(Exit(0))
+
+			duration, exited := session.RunDuration()
+			Expect(exited).Should(BeTrue())
+			Expect(duration).Should(BeNumerically(">=", 200*time.Millisecond))
+
+			time.Sleep(20 * time.Millisecond)
+			laterDuration, _ := session.RunDuration()
+			Expect(laterDuration).Should(Equal(duration))
+		})
+
+		It("should be 0 if the command did not start", func() {
+			session, err := Start(exec.Command("notexisting"), GinkgoWriter, GinkgoWriter)
+			Expect(err).To(HaveOccurred())
+
+			duration, exited := session.RunDuration()
+			Expect(exited).Should(BeFalse())
+			Expect(duration).Should(BeZero())
+		})
+	})
+
+	Describe("should exit within", func() {
+		It("should pass when the command exits within the window", func() {
+			session, err := Start(exec.Command("sleep", "0.2"), GinkgoWriter, GinkgoWriter)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			failures := InterceptGomegaFailures(func() {
+				session.ShouldExitWithin(100*time.Millisecond, 3*time.Second)
+			})
+			Expect(failures).Should(BeEmpty())
+			Expect(session).Should(Exit(0))
+		})
+
+		It("should fail when the command exits too early", func() {
+			session, err := Start(exec.Command("sleep", "0"), GinkgoWriter, GinkgoWriter)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			failures := InterceptGomegaFailures(func() {
+				session.ShouldExitWithin(500*time.Millisecond, 3*time.Second)
+			})
+			Expect(failures).Should(HaveLen(1))
+			Expect(failures[0]).Should(ContainSubstring("Expected process to run for at least 500ms before exiting"))
+		})
+
+		It("should fail when the command is still running after the window", func() {
+			session, err := Start(exec.Command("sleep", "10000000"), GinkgoWriter, GinkgoWriter)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			t := time.Now()
+			failures := InterceptGomegaFailures(func() {
+				session.ShouldExitWithin(0, 200*time.Millisecond)
+			})
+			Expect(time.Since(t)).Should(BeNumerically("<", time.Second))
+			Expect(failures).Should(HaveLen(1))
+			Expect(failures[0]).Should(ContainSubstring("Expected process to exit within 200ms"))
+
+			session.Kill().Wait()
+		})
+	})
+
 	Describe("wait", func() {
 		It("should wait till the command exits", func() {
 			Expect(session.ExitCode()).Should(Equal(-1))
@@ -80,6 +199,318 @@ var _ = Describe("Session", func() {
 		})
 	})
 
+	Describe("TerminateAndExpect", func() {
+		startGraceful := func(onTerm string) *Session {
+			script := fmt.Sprintf(`trap '%s' TERM; echo ready; while true; do sleep 0.05; done`, onTerm)
+			session, err := Start(exec.Command("sh", "-c", script), nil, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Eventually(session).Should(Say("ready"))
+			return session.WithDefaultTimeout(time.Second)
+		}
+
+		It("should pass when the command logs the sequence and exits", func() {
+			session := startGraceful("echo draining; echo stopped; exit 0")
+
+			failures := InterceptGomegaFailures(func() {
+				Expect(session.TerminateAndExpect("draining", "stopped")).Should(Equal(session))
+			})
+			Expect(failures).Should(BeEmpty())
+			Expect(session.ExitCode()).Should(Equal(0))
+		})
+
+		It("should report the missing step when the command does not log the sequence", func() {
+			session := startGraceful("echo stopped; echo draining; exit 0")
+
+			failures := InterceptGomegaFailures(func() {
+				session.TerminateAndExpect("draining", "stopped")
+			})
+			Expect(failures).Should(HaveLen(1))
+			Expect(failures[0]).Should(ContainSubstring(`did not log the expected shutdown sequence after SIGTERM: step 2 of 2 ("stopped") did not appear`))
+			Expect(failures[0]).Should(ContainSubstring("Stdout (last"))
+		})
+
+		It("should report when the command logs the sequence but does not exit", func() {
+			session := startGraceful("echo draining; echo stopped")
+			defer func() {
+				session.Kill().Wait()
+			}()
+			session.WithDefaultTimeout(200 * time.Millisecond)
+
+			failures := InterceptGomegaFailures(func() {
+				session.TerminateAndExpect("draining", "stopped")
+			})
+			Expect(failures).Should(HaveLen(1))
+			Expect(failures[0]).Should(ContainSubstring("logged the expected shutdown sequence after SIGTERM but did not exit"))
+		})
+	})
+
+	Describe("default timeout", func() {
+		var sleeper *Session
+
+		BeforeEach(func() {
+			var err error
+			sleeper, err = Start(exec.Command("sleep", "10"), nil, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			sleeper.Kill().Wait(time.Second)
+		})
+
+		timeFailure := func(f func()) time.Duration {
+			t := time.Now()
+			failures := InterceptGomegaFailures(f)
+			Expect(failures).Should(HaveLen(1))
+			return time.Since(t)
+		}
+
+		It("should be honored by Wait and AssertExit", func() {
+			Expect(sleeper.WithDefaultTimeout(100 * time.Millisecond)).Should(Equal(sleeper))
+
+			Expect(timeFailure(func() { sleeper.Wait() })).Should(And(BeNumerically(">=", 100*time.Millisecond), BeNumerically("<", 800*time.Millisecond)))
+			Expect(timeFailure(func() { AssertExit(sleeper, 0) })).Should(And(BeNumerically(">=", 100*time.Millisecond), BeNumerically("<", 800*time.Millisecond)))
+		})
+
+		It("should be overridable per call", func() {
+			sleeper.WithDefaultTimeout(time.Minute)
+
+			Expect(timeFailure(func() { sleeper.Wait(0.1) })).Should(BeNumerically("<", time.Second))
+			Expect(timeFailure(func() { AssertExit(sleeper, 0, 100*time.Millisecond) })).Should(BeNumerically("<", time.Second))
+		})
+
+		It("should fall back to Gomega's default when unset", func() {
+			SetDefaultEventuallyTimeout(150 * time.Millisecond)
+			defer SetDefaultEventuallyTimeout(time.Second)
+
+			Expect(timeFailure(func() { sleeper.Wait() })).Should(And(BeNumerically(">=", 150*time.Millisecond), BeNumerically("<", 800*time.Millisecond)))
+
+			sleeper.WithDefaultTimeout(50 * time.Millisecond).WithDefaultTimeout(0)
+			Expect(timeFailure(func() { sleeper.Wait() })).Should(And(BeNumerically(">=", 150*time.Millisecond), BeNumerically("<", 800*time.Millisecond)))
+		})
+	})
+
+	Describe("complete", func() {
+		It("should wait for the command to exit and return its output and exit code", func() {
+			stdout, stderr, code, err := session.Complete(5 * time.Second)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stdout).Should(ContainSubstring("We've done the impossible, and that makes us mighty"))
+			Expect(stderr).Should(ContainSubstring("Ah, curse your sudden but inevitable betrayal!"))
+			Expect(code).Should(Equal(session.ExitCode()))
+			Expect(code).Should(BeNumerically(">=", 0))
+			Expect(code).Should(BeNumerically("<", 3))
+		})
+
+		It("should return an error and leave the command running when it times out", func() {
+			slowSession, err := Start(exec.Command("sh", "-c", "echo started; echo grumbling >&2; exec sleep 10"), nil, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			defer func() {
+				slowSession.Kill().Wait()
+			}()
+			Eventually(slowSession.Err).Should(Say("grumbling"))
+
+			stdout, stderr, code, err := slowSession.Complete(100 * time.Millisecond)
+			Expect(err).Should(MatchError(ContainSubstring("did not exit within 100ms and is still running")))
+			Expect(stdout).Should(Equal("started\n"))
+			Expect(stderr).Should(Equal("grumbling\n"))
+			Expect(code).Should(Equal(-1))
+			Expect(slowSession.ExitCode()).Should(Equal(-1))
+		})
+	})
+
+	Describe("stripping ANSI escape sequences", func() {
+		It("should strip the buffers but pass raw output to the writers", func() {
+			rawOut := NewBuffer()
+			rawErr := NewBuffer()
+			colorful, err := Start(exec.Command("sh", "-c", `printf '\033[31mred\033[0m\n'; printf '\033[1mbold\033[0m\n' >&2`), rawOut, rawErr, StripANSIFromBuffers())
+			Expect(err).ShouldNot(HaveOccurred())
+			Eventually(colorful).Should(Exit(0))
+
+			Expect(colorful.Out.Contents()).Should(Equal([]byte("red\n")))
+			Expect(colorful.Err.Contents()).Should(Equal([]byte("bold\n")))
+			Expect(rawOut.Contents()).Should(Equal([]byte("\x1b[31mred\x1b[0m\n")))
+			Expect(rawErr.Contents()).Should(Equal([]byte("\x1b[1mbold\x1b[0m\n")))
+		})
+	})
+
+	Describe("being notified of the first write to stderr", func() {
+		It("should invoke the callback exactly once and still buffer stderr", func() {
+			var lock sync.Mutex
+			calls := [][]byte{}
+			noisy, err := Start(exec.Command("sh", "-c", "echo quiet; sleep 0.05; echo oops >&2; sleep 0.05; echo again >&2"), nil, nil, OnFirstStderrWrite(func(data []byte) {
+				lock.Lock()
+				defer lock.Unlock()
+				calls = append(calls, data)
+			}))
+			Expect(err).ShouldNot(HaveOccurred())
+			Eventually(noisy).Should(Exit(0))
+
+			lock.Lock()
+			defer lock.Unlock()
+			Expect(calls).Should(Equal([][]byte{[]byte("oops\n")}))
+			Expect(noisy.Err.Contents()).Should(Equal([]byte("oops\nagain\n")))
+			Expect(noisy.Out.Contents()).Should(Equal([]byte("quiet\n")))
+		})
+
+		It("should not invoke the callback when nothing is written to stderr", func() {
+			called := false
+			quiet, err := Start(exec.Command("echo", "quiet"), nil, nil, OnFirstStderrWrite(func([]byte) {
+				called = true
+			}))
+			Expect(err).ShouldNot(HaveOccurred())
+			Eventually(quiet).Should(Exit(0))
+			Expect(called).Should(BeFalse())
+		})
+	})
+
+	Describe("writing to stdin", func() {
+		It("should send lines to the command", func() {
+			repl, err := Start(exec.Command("sh", "-c", `while read line; do echo "you said: $line"; done; echo bye`), nil, nil, WithStdin())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(repl.WriteLine("hello %s", "world")).Should(Succeed())
+			Eventually(repl.Out).Should(Say("you said: hello world\n"))
+
+			Expect(repl.WriteLine("100%% done")).Should(Succeed())
+			Eventually(repl.Out).Should(Say("you said: 100% done\n"))
+
+			Expect(repl.CloseStdin()).Should(Succeed())
+			Eventually(repl).Should(Exit(0))
+			Expect(repl.Out).Should(Say("bye"))
+
+			Expect(repl.WriteLine("too late")).ShouldNot(Succeed())
+		})
+
+		It("should error when the session was started without stdin", func() {
+			Expect(session.WriteLine("hello")).Should(MatchError(ContainSubstring("session was started without stdin")))
+			Expect(session.CloseStdin()).Should(MatchError(ContainSubstring("session was started without stdin")))
+		})
+
+		It("should fail to start when the command already has stdin", func() {
+			command := exec.Command("cat")
+			command.Stdin = &bytes.Buffer{}
+			_, err := Start(command, nil, nil, WithStdin())
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Describe("killed forcefully", func() {
+		It("should be set when a process ignoring SIGTERM has to be killed", func() {
+			stubborn := startScript("trap '' TERM; echo trapped; while true; do sleep 0.05; done")
+			Eventually(stubborn).Should(Say("trapped"))
+
+			stubborn.Terminate()
+			Consistently(stubborn, 0.2).ShouldNot(Exit())
+			Expect(stubborn.KilledForcefully()).Should(BeFalse())
+
+			stubborn.Kill().Wait()
+			Expect(stubborn.KilledForcefully()).Should(BeTrue())
+			Expect(stubborn.ExitCode()).Should(Equal(128 + int(syscall.SIGKILL)))
+		})
+
+		It("should not be set when the process exits in response to SIGTERM", func() {
+			obliging := startScript("echo ready; exec sleep 10")
+			Eventually(obliging).Should(Say("ready"))
+
+			obliging.Terminate().Wait()
+			Expect(obliging.KilledForcefully()).Should(BeFalse())
+		})
+
+		It("should not be set when the process exits on its own", func() {
+			Expect(session.Wait().KilledForcefully()).Should(BeFalse())
+		})
+	})
+
+	Describe("exit info", func() {
+		It("should be unavailable while the command is running", func() {
+			running, err := Start(exec.Command("sleep", "10"), nil, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			defer func() {
+				running.Kill().Wait()
+			}()
+
+			info, exited := running.ExitInfo()
+			Expect(exited).Should(BeFalse())
+			Expect(info).Should(Equal(ExitInfo{}))
+		})
+
+		It("should describe a normal exit", func() {
+			session, err := Start(exec.Command("sh", "-c", "sleep 0.1; exit 3"), nil, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Eventually(session).Should(Exit())
+
+			info, exited := session.ExitInfo()
+			Expect(exited).Should(BeTrue())
+			Expect(info.Code).Should(Equal(3))
+			Expect(info.Signaled).Should(BeFalse())
+			Expect(info.Signal).Should(BeNil())
+			Expect(info.Duration).Should(BeNumerically(">=", 100*time.Millisecond))
+			duration, _ := session.RunDuration()
+			Expect(info.Duration).Should(Equal(duration))
+			Expect(info.Err).Should(BeAssignableToTypeOf(&exec.ExitError{}))
+		})
+
+		It("should have no error for a clean exit", func() {
+			session, err := Start(exec.Command("true"), nil, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Eventually(session).Should(Exit(0))
+
+			info, exited := session.ExitInfo()
+			Expect(exited).Should(BeTrue())
+			Expect(info.Code).Should(Equal(0))
+			Expect(info.Err).ShouldNot(HaveOccurred())
+		})
+
+		It("should describe a signal-terminated exit", func() {
+			session, err := Start(exec.Command("sleep", "10"), nil, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			session.Terminate().Wait()
+
+			info, exited := session.ExitInfo()
+			Expect(exited).Should(BeTrue())
+			Expect(info.Code).Should(Equal(128 + int(syscall.SIGTERM)))
+			Expect(info.Signaled).Should(BeTrue())
+			Expect(info.Signal).Should(Equal(syscall.SIGTERM))
+			Expect(info.Err).Should(HaveOccurred())
+		})
+	})
+
+	Describe("signaled", func() {
+		It("should report SIGTERM, with an exit code of 128+15", func() {
+			session := startScript("echo ready; exec sleep 10")
+			Eventually(session).Should(Say("ready"))
+			signal, ok := session.Signaled()
+			Expect(ok).Should(BeFalse())
+			Expect(signal).Should(BeNil())
+
+			session.Terminate().Wait()
+			signal, ok = session.Signaled()
+			Expect(ok).Should(BeTrue())
+			Expect(signal).Should(Equal(syscall.SIGTERM))
+			Expect(session.ExitCode()).Should(Equal(128 + 15))
+		})
+
+		It("should report SIGKILL, with an exit code of 128+9", func() {
+			session := startScript("echo ready; exec sleep 10")
+			Eventually(session).Should(Say("ready"))
+
+			session.Kill().Wait()
+			signal, ok := session.Signaled()
+			Expect(ok).Should(BeTrue())
+			Expect(signal).Should(Equal(syscall.SIGKILL))
+			Expect(session.ExitCode()).Should(Equal(128 + 9))
+		})
+
+		It("should return false when the process exits from its own signal handler", func() {
+			session := startScript("trap 'exit 3' TERM; echo ready; while true; do sleep 0.05; done")
+			Eventually(session).Should(Say("ready"))
+
+			session.Terminate().Wait()
+			_, ok := session.Signaled()
+			Expect(ok).Should(BeFalse())
+			Expect(session.ExitCode()).Should(Equal(3))
+		})
+	})
+
 	Describe("exited", func() {
 		It("should close when the command exits", func() {
 			Eventually(session.Exited).Should(BeClosed())
@@ -250,6 +681,46 @@ var _ = Describe("Session", func() {
 			})
 		})
 
+		Describe("dumpSessions", func() {
+			It("should write a summary of every started session", func() {
+				running, err := Start(exec.Command("sh", "-c", "echo running-out; echo running-err >&2; exec sleep 10000000"), GinkgoWriter, GinkgoWriter)
+				Expect(err).ShouldNot(HaveOccurred())
+				Eventually(running.Err).Should(Say("running-err"))
+
+				exited, err := Start(exec.Command("sh", "-c", "echo line-1; echo line-2; echo line-3; exit 3"), GinkgoWriter, GinkgoWriter)
+				Expect(err).ShouldNot(HaveOccurred())
+				Eventually(exited).Should(Exit(3))
+
+				dump := NewBuffer()
+				DumpSessions(dump)
+
+				Expect(dump).Should(Say(`sh -c echo running-out; echo running-err >&2; exec sleep 10000000`))
+				Expect(dump).Should(Say(`State: running \(pid %d\)`, running.Command.Process.Pid))
+				Expect(dump).Should(Say(`Stdout \(last 20 lines\):\n\s+running-out`))
+				Expect(dump).Should(Say(`Stderr \(last 20 lines\):\n\s+running-err`))
+
+				Expect(dump).Should(Say(`sh -c echo line-1; echo line-2; echo line-3; exit 3`))
+				Expect(dump).Should(Say(`State: exited with code 3`))
+				Expect(dump).Should(Say(`line-1\n\s+line-2\n\s+line-3`))
+			})
+
+			It("should respect OutputTailLines", func() {
+				originalTailLines := OutputTailLines
+				OutputTailLines = 2
+				defer func() { OutputTailLines = originalTailLines }()
+
+				session, err := Start(exec.Command("sh", "-c", "echo line-1; echo line-2; echo line-3"), GinkgoWriter, GinkgoWriter)
+				Expect(err).ShouldNot(HaveOccurred())
+				Eventually(session).Should(Exit(0))
+
+				dump := NewBuffer()
+				DumpSessions(dump)
+
+				Expect(dump).Should(Say(`Stdout \(last 2 lines\):\n\s+line-2\n\s+line-3`))
+				Expect(string(dump.Contents())).ShouldNot(MatchRegexp(`\n\s+line-1\n`))
+			})
+		})
+
 		Describe("interrupt", func() {
 			It("should interrupt all the started sessions, and not wait", func() {
 				session1, err := Start(exec.Command("sleep", "10000000"), GinkgoWriter, GinkgoWriter)
@@ -336,3 +807,47 @@ var _ = Describe("Session", func() {
 		})
 	})
 })
+
+var _ = Describe("exit code extraction", func() {
+	var restore func()
+
+	AfterEach(func() {
+		if restore != nil {
+			restore()
+			restore = nil
+		}
+	})
+
+	finishedProcessState := func() *os.ProcessState {
+		command := exec.Command("true")
+		Expect(command.Run()).To(Succeed())
+		return command.ProcessState
+	}
+
+	It("uses the injected extractor", func() {
+		restore = SetExitCodeFromProcessState(func(*os.ProcessState) int { return 42 })
+		Expect(GetExitCode(finishedProcessState(), nil)).To(Equal(42))
+	})
+
+	It("reports INVALID_EXIT_CODE when the extractor can't determine a code and Wait errored", func() {
+		restore = SetExitCodeFromProcessState(func(*os.ProcessState) int { return -1 })
+		Expect(GetExitCode(finishedProcessState(), errors.New("boom"))).To(Equal(INVALID_EXIT_CODE))
+	})
+
+	It("reports INVALID_EXIT_CODE when there is no process state", func() {
+		Expect(GetExitCode(nil, errors.New("boom"))).To(Equal(INVALID_EXIT_CODE))
+	})
+
+	It("is used by sessions", func() {
+		restore = SetExitCodeFromProcessState(func(*os.ProcessState) int { return 17 })
+		session, err := Start(exec.Command("true"), nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(session).Should(Exit(17))
+	})
+
+	It("defaults to the process's real exit status", func() {
+		command := exec.Command("sh", "-c", "exit 3")
+		Expect(command.Run()).To(HaveOccurred())
+		Expect(GetExitCode(command.ProcessState, nil)).To(Equal(3))
+	})
+})
@@ -0,0 +1,45 @@
+package gexec
+
+import (
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+)
+
+var shellSafe = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
+/*
+ShellCommand returns an *exec.Cmd that runs a command line through sh -c.  The command line is built with fmt.Sprintf,
+but each of the args is first converted to a string and quoted with ShellQuote, so arguments containing spaces, quotes
+or shell metacharacters reach the command intact.  Use %s for every argument:
+
+	command := gexec.ShellCommand("%s --name %s | grep %s > %s", pathToCLI, "my app", "it's", outputPath)
+	session, err := gexec.Start(command, GinkgoWriter, GinkgoWriter)
+
+Only the args are quoted: the format string is passed to the shell as-is, so pipes and redirections in it work as usual.
+
+ShellCommand requires a POSIX shell and uses POSIX quoting rules.  It is intended for Unix; on Windows it only works
+if an sh (such as the one shipped with Git for Windows) is on the PATH.
+*/
+func ShellCommand(format string, args ...interface{}) *exec.Cmd {
+	quoted := make([]interface{}, len(args))
+	for i, arg := range args {
+		quoted[i] = ShellQuote(fmt.Sprint(arg))
+	}
+	return exec.Command("sh", "-c", fmt.Sprintf(format, quoted...))
+}
+
+/*
+ShellQuote quotes s so that a POSIX shell treats it as a single, literal word.
+Strings made up only of characters that are never special to the shell are returned unchanged.
+*/
+func ShellQuote(s string) string {
+	if s == "" {
+		return "''"
+	}
+	if shellSafe.MatchString(s) {
+		return s
+	}
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
@@ -0,0 +1,65 @@
+// +build !windows
+
+package gexec_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("ShellCommand", func() {
+	run := func(format string, args ...interface{}) string {
+		session, err := Start(ShellCommand(format, args...), nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(session).Should(Exit(0))
+		return string(session.Out.Contents())
+	}
+
+	It("runs the command through sh", func() {
+		Expect(ShellCommand("echo hi").Args).To(Equal([]string{"sh", "-c", "echo hi"}))
+		Expect(run("echo hi | tr a-z A-Z")).To(Equal("HI\n"))
+	})
+
+	It("passes arguments containing spaces as single words", func() {
+		Expect(run(`printf '[%%s]\n' %s %s`, "two words", "  padded  ")).To(Equal("[two words]\n[  padded  ]\n"))
+	})
+
+	It("passes arguments containing quotes intact", func() {
+		Expect(run(`printf '[%%s]\n' %s %s %s`, "it's", `say "hi"`, `'''`)).To(Equal("[it's]\n[say \"hi\"]\n[''']\n"))
+	})
+
+	It("does not let special characters reach the shell", func() {
+		dir, err := ioutil.TempDir("", "shell-command")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+		canary := filepath.Join(dir, "canary")
+
+		args := []interface{}{"$HOME", "`touch " + canary + "`", "$(touch " + canary + ")", "; touch " + canary, "a|b&c>d<e", "*", "\\n", "back\\slash", ""}
+		Expect(run(`printf '[%%s]\n' %s %s %s %s %s %s %s %s %s`, args...)).To(Equal(
+			"[$HOME]\n[`touch " + canary + "`]\n[$(touch " + canary + ")]\n[; touch " + canary + "]\n[a|b&c>d<e]\n[*]\n[\\n]\n[back\\slash]\n[]\n"))
+		Expect(canary).NotTo(BeAnExistingFile())
+	})
+
+	It("converts non-string arguments", func() {
+		Expect(run("echo %s %s", 42, true)).To(Equal("42 true\n"))
+	})
+})
+
+var _ = Describe("ShellQuote", func() {
+	It("leaves safe strings unchanged", func() {
+		Expect(ShellQuote("simple")).To(Equal("simple"))
+		Expect(ShellQuote("/path/to/file-1.txt")).To(Equal("/path/to/file-1.txt"))
+		Expect(ShellQuote("--flag=value")).To(Equal("--flag=value"))
+	})
+
+	It("quotes everything else", func() {
+		Expect(ShellQuote("")).To(Equal("''"))
+		Expect(ShellQuote("two words")).To(Equal("'two words'"))
+		Expect(ShellQuote("it's")).To(Equal(`'it'\''s'`))
+	})
+})
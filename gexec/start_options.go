@@ -0,0 +1,81 @@
+package gexec
+
+import "sync"
+
+/*
+StartOption configures how Start wires up a command.  Pass any number of options after the outWriter and errWriter:
+
+	session, err := gexec.Start(command, GinkgoWriter, GinkgoWriter, gexec.StripANSIFromBuffers())
+*/
+type StartOption func(*startConfig)
+
+type startConfig struct {
+	stripANSI          bool
+	onFirstStderrWrite func([]byte)
+	stdin              bool
+	lineTransforms     []func(string) string
+}
+
+func newStartConfig(options []StartOption) *startConfig {
+	config := &startConfig{}
+	for _, option := range options {
+		option(config)
+	}
+	return config
+}
+
+/*
+StripANSIFromBuffers strips ANSI escape sequences from the output that lands in the session's Out and Err buffers, so that
+Say patterns can ignore colors.  The outWriter and errWriter passed to Start still receive the raw, colorized bytes.
+*/
+func StripANSIFromBuffers() StartOption {
+	return func(config *startConfig) {
+		config.stripANSI = true
+	}
+}
+
+/*
+WithStdin connects a pipe to the command's stdin, so that Session.WriteLine and Session.CloseStdin can drive it.
+Start returns an error if the command's Stdin is already set.
+*/
+func WithStdin() StartOption {
+	return func(config *startConfig) {
+		config.stdin = true
+	}
+}
+
+/*
+OnFirstStderrWrite registers a callback that is invoked, exactly once, with the first chunk of output the command writes
+to stderr.  Output is still captured in the session's Err buffer (and passed to errWriter) as usual.
+
+This supports "any stderr output is a failure" policies for tools that should be silent on success.  The callback runs on
+the goroutine copying the command's output, so with Ginkgo use GinkgoRecover when failing from it:
+
+	session, err := gexec.Start(command, GinkgoWriter, GinkgoWriter, gexec.OnFirstStderrWrite(func(data []byte) {
+		defer GinkgoRecover()
+		Fail(fmt.Sprintf("unexpected output on stderr: %q", data))
+	}))
+
+The command's stderr is blocked while the callback runs, so it should return promptly.
+*/
+func OnFirstStderrWrite(callback func([]byte)) StartOption {
+	return func(config *startConfig) {
+		config.onFirstStderrWrite = callback
+	}
+}
+
+type firstWriteNotifier struct {
+	callback func([]byte)
+	once     sync.Once
+}
+
+func (n *firstWriteNotifier) Write(p []byte) (int, error) {
+	if len(p) > 0 {
+		n.once.Do(func() {
+			data := make([]byte, len(p))
+			copy(data, p)
+			n.callback(data)
+		})
+	}
+	return len(p), nil
+}
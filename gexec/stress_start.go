@@ -0,0 +1,93 @@
+package gexec
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/onsi/gomega/format"
+	"github.com/onsi/gomega/internal/oraclematcher"
+	"github.com/onsi/gomega/types"
+)
+
+//StressStartPollingInterval is how often StressStart checks the readiness matcher.
+var StressStartPollingInterval = 10 * time.Millisecond
+
+/*
+StressStart starts a fresh command from factory iterations times, one after another, to flush out intermittent startup
+failures.  Each instance must start and then satisfy the readiness matcher within timeout; it is then killed with
+SIGKILL and reaped before the next instance starts:
+
+	err := gexec.StressStart(func() *exec.Cmd {
+		return exec.Command(pathToServer, "--port", "0")
+	}, 20, gbytes.Say("listening"), 5*time.Second)
+	Expect(err).ShouldNot(HaveOccurred())
+
+The readiness matcher is matched against the *Session, so gbytes.Say matches stdout; matchers can also inspect
+session.Err or the exit code.  Every iteration runs even when earlier ones fail, and StressStart returns an error
+describing each failing iteration (numbered from 1) with the tail of its output, or nil if every iteration succeeded.
+
+An instance that does not exit within timeout of being killed is reported as a failure too, so a hung process cannot
+stall the loop.
+*/
+func StressStart(factory func() *exec.Cmd, iterations int, readiness types.GomegaMatcher, timeout time.Duration) error {
+	failures := []string{}
+	for iteration := 1; iteration <= iterations; iteration++ {
+		if err := stressStartOnce(factory(), readiness, timeout); err != nil {
+			failures = append(failures, fmt.Sprintf("iteration %d of %d: %s", iteration, iterations, err))
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d of %d starts failed:\n%s", len(failures), iterations, strings.Join(failures, "\n"))
+}
+
+func stressStartOnce(command *exec.Cmd, readiness types.GomegaMatcher, timeout time.Duration) error {
+	session, err := Start(command, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start: %s", err)
+	}
+
+	readinessErr := awaitReadiness(session, readiness, timeout)
+
+	session.Kill()
+	select {
+	case <-session.Exited:
+	case <-time.After(timeout):
+		return fmt.Errorf("did not exit within %s of being killed (pid %d)", timeout, session.PID())
+	}
+
+	if readinessErr != nil {
+		return fmt.Errorf("%s\n%s", readinessErr, format.IndentString(OutputTail(session)(), 1))
+	}
+	return nil
+}
+
+func awaitReadiness(session *Session, readiness types.GomegaMatcher, timeout time.Duration) error {
+	deadline := time.After(timeout)
+	for {
+		success, err := readiness.Match(session)
+		if err != nil {
+			return err
+		}
+		if success {
+			return nil
+		}
+		if !oraclematcher.MatchMayChangeInTheFuture(readiness, session) {
+			if exitCode := session.ExitCode(); exitCode != -1 {
+				return fmt.Errorf("exited with code %d before becoming ready:\n%s", exitCode, readiness.FailureMessage(session))
+			}
+			return errors.New("can no longer become ready:\n" + readiness.FailureMessage(session))
+		}
+
+		select {
+		case <-time.After(StressStartPollingInterval):
+		case <-deadline:
+			return fmt.Errorf("did not become ready within %s:\n%s", timeout, readiness.FailureMessage(session))
+		}
+	}
+}
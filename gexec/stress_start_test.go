@@ -0,0 +1,75 @@
+// +build !windows
+
+package gexec_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("StressStart", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "stress-start")
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	//flaky counts its runs in a file and misbehaves on its third run
+	flaky := func(misbehaviour string) func() *exec.Cmd {
+		script := `n=$(cat count 2>/dev/null || echo 0); n=$((n+1)); echo $n > count; if [ $n = 3 ]; then ` + misbehaviour + `; fi; echo ready; exec sleep 10`
+		return func() *exec.Cmd {
+			command := exec.Command("sh", "-c", script)
+			command.Dir = dir
+			return command
+		}
+	}
+
+	runs := func() string {
+		contents, err := ioutil.ReadFile(filepath.Join(dir, "count"))
+		Expect(err).ShouldNot(HaveOccurred())
+		return string(contents)
+	}
+
+	It("should succeed when every start becomes ready", func() {
+		Expect(StressStart(flaky("true"), 5, Say("ready"), time.Second)).Should(Succeed())
+		Expect(runs()).Should(Equal("5\n"))
+	})
+
+	It("should report the iteration that exited before becoming ready", func() {
+		err := StressStart(flaky("echo crashing >&2; exit 2"), 5, Say("ready"), time.Second)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(HavePrefix("1 of 5 starts failed:\niteration 3 of 5: exited with code 2 before becoming ready:\n"))
+		Expect(err.Error()).Should(ContainSubstring("crashing"))
+		Expect(runs()).Should(Equal("5\n"))
+	})
+
+	It("should report the iteration that did not become ready in time, and reap it", func() {
+		t := time.Now()
+		err := StressStart(flaky("exec sleep 10"), 4, Say("ready"), 200*time.Millisecond)
+		Expect(time.Since(t)).Should(BeNumerically("<", 3*time.Second))
+		Expect(err).Should(MatchError(ContainSubstring("iteration 3 of 4: did not become ready within 200ms")))
+		Expect(err.Error()).ShouldNot(ContainSubstring("iteration 1 "))
+		Expect(runs()).Should(Equal("4\n"))
+	})
+
+	It("should report commands that fail to start", func() {
+		err := StressStart(func() *exec.Cmd {
+			return exec.Command(filepath.Join(dir, "does-not-exist"))
+		}, 2, Say("ready"), time.Second)
+		Expect(err).Should(MatchError(ContainSubstring("2 of 2 starts failed:\niteration 1 of 2: failed to start")))
+	})
+})
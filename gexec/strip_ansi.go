@@ -0,0 +1,108 @@
+package gexec
+
+import (
+	"io"
+	"sync"
+)
+
+type ansiState int
+
+const (
+	ansiGround ansiState = iota
+	ansiEscape
+	ansiEscapeIntermediate
+	ansiCSI
+	ansiOSC
+	ansiOSCEscape
+)
+
+const escape = 0x1b
+
+/*
+ANSIStripper is an io.Writer that removes ANSI escape sequences (colors, cursor movement, window titles, ...) from
+everything written through it before passing it on.  Create one with StripANSI.
+
+Escape sequences split across writes are handled: the stripper remembers where it is within a sequence between calls to Write.
+*/
+type ANSIStripper struct {
+	writer io.Writer
+	lock   *sync.Mutex
+	state  ansiState
+}
+
+/*
+StripANSI returns an ANSIStripper that writes to the passed-in writer.  This makes it possible to assert on the text
+emitted by colorized CLIs:
+
+	gexec.Start(cmd, gexec.StripANSI(GinkgoWriter), gexec.StripANSI(GinkgoWriter))
+
+To have the session's buffers receive stripped output while outWriter and errWriter still receive the raw bytes, pass the
+StripANSIFromBuffers option to Start instead.
+
+ANSIStripper is safe for concurrent use.
+*/
+func StripANSI(writer io.Writer) *ANSIStripper {
+	return &ANSIStripper{
+		writer: writer,
+		lock:   &sync.Mutex{},
+	}
+}
+
+func (w *ANSIStripper) Write(b []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	toWrite := make([]byte, 0, len(b))
+
+	for _, c := range b {
+		switch w.state {
+		case ansiGround:
+			if c == escape {
+				w.state = ansiEscape
+			} else {
+				toWrite = append(toWrite, c)
+			}
+		case ansiEscape:
+			switch {
+			case c == '[':
+				w.state = ansiCSI
+			case c == ']':
+				w.state = ansiOSC
+			case 0x20 <= c && c <= 0x2f:
+				w.state = ansiEscapeIntermediate
+			case c == escape:
+			default:
+				w.state = ansiGround
+			}
+		case ansiEscapeIntermediate:
+			if !(0x20 <= c && c <= 0x2f) {
+				w.state = ansiGround
+			}
+		case ansiCSI:
+			if 0x40 <= c && c <= 0x7e {
+				w.state = ansiGround
+			}
+		case ansiOSC:
+			if c == '\a' {
+				w.state = ansiGround
+			} else if c == escape {
+				w.state = ansiOSCEscape
+			}
+		case ansiOSCEscape:
+			if c == '\\' {
+				w.state = ansiGround
+			} else if c != escape {
+				w.state = ansiOSC
+			}
+		}
+	}
+
+	if len(toWrite) > 0 {
+		_, err := w.writer.Write(toWrite)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(b), nil
+}
@@ -0,0 +1,59 @@
+package gexec_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/gomega/gexec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StripANSI", func() {
+	var buffer *bytes.Buffer
+	var writer *ANSIStripper
+	BeforeEach(func() {
+		buffer = &bytes.Buffer{}
+		writer = StripANSI(buffer)
+	})
+
+	It("should remove color codes", func() {
+		n, err := writer.Write([]byte("\x1b[1;31mred\x1b[0m and \x1b[32mgreen\x1b[m\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(n).To(Equal(len("\x1b[1;31mred\x1b[0m and \x1b[32mgreen\x1b[m\n")))
+		Expect(buffer.String()).To(Equal("red and green\n"))
+	})
+
+	It("should remove CSI sequences split across writes", func() {
+		writer.Write([]byte("before \x1b"))
+		writer.Write([]byte("[3"))
+		writer.Write([]byte("8;5;20"))
+		writer.Write([]byte("8mafter\x1b["))
+		writer.Write([]byte("0m\n"))
+		Expect(buffer.String()).To(Equal("before after\n"))
+	})
+
+	It("should handle input written one byte at a time", func() {
+		input := "\x1b[2J\x1b[Hclean\x1b]0;title\x07 text\x1b(B\n"
+		for i := 0; i < len(input); i++ {
+			writer.Write([]byte{input[i]})
+		}
+		Expect(buffer.String()).To(Equal("clean text\n"))
+	})
+
+	It("should remove OSC sequences terminated by BEL or ST", func() {
+		writer.Write([]byte("a\x1b]0;window title\x07b\x1b]8;;http://example.com\x1b"))
+		writer.Write([]byte("\\link\x1b]8;;\x1b\\c"))
+		Expect(buffer.String()).To(Equal("ablinkc"))
+	})
+
+	It("should remove two-character escape sequences", func() {
+		writer.Write([]byte("a\x1b7b\x1b8c\x1b(Bd"))
+		Expect(buffer.String()).To(Equal("abcd"))
+	})
+
+	It("should leave text without escape sequences untouched", func() {
+		writer.Write([]byte("plain [brackets] ] and unicode ✓\n"))
+		Expect(buffer.String()).To(Equal("plain [brackets] ] and unicode ✓\n"))
+	})
+})
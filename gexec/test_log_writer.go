@@ -0,0 +1,72 @@
+package gexec
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+/*
+TestLogger is the subset of testing.TB used by TestLogWriter.  *testing.T and *testing.B both satisfy it.
+*/
+type TestLogger interface {
+	Log(args ...interface{})
+}
+
+/*
+TestLogWriter is an io.Writer that sends each complete line written to it to a TestLogger's Log method.
+This makes it easy to route the output of a gexec.Session into a plain `testing` test, where it is captured per-test
+and shown only when the test fails or when running with -v:
+
+	session, err := gexec.Start(cmd, gexec.NewTestLogWriter(t), gexec.NewTestLogWriter(t))
+
+Output is buffered until a newline arrives.  Start calls Flush when the command exits, logging a trailing partial line.
+
+TestLogWriter is safe for concurrent use.
+*/
+type TestLogWriter struct {
+	logger TestLogger
+	lock   *sync.Mutex
+	buffer []byte
+}
+
+var _ io.Writer = &TestLogWriter{}
+
+func NewTestLogWriter(logger TestLogger) *TestLogWriter {
+	return &TestLogWriter{
+		logger: logger,
+		lock:   &sync.Mutex{},
+	}
+}
+
+func (w *TestLogWriter) Write(b []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.buffer = append(w.buffer, b...)
+	for {
+		i := bytes.IndexByte(w.buffer, '\n')
+		if i < 0 {
+			break
+		}
+		w.logger.Log(string(w.buffer[:i]))
+		w.buffer = w.buffer[i+1:]
+	}
+
+	return len(b), nil
+}
+
+/*
+Flush logs any buffered partial line.
+*/
+func (w *TestLogWriter) Flush() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if len(w.buffer) > 0 {
+		w.logger.Log(string(w.buffer))
+		w.buffer = nil
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package gexec_test
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"sync"
+
+	. "github.com/onsi/gomega/gexec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type fakeTestLogger struct {
+	lock  sync.Mutex
+	lines []string
+}
+
+func (l *fakeTestLogger) Log(args ...interface{}) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.lines = append(l.lines, fmt.Sprint(args...))
+}
+
+func (l *fakeTestLogger) Lines() []string {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return append([]string{}, l.lines...)
+}
+
+var _ = Describe("TestLogWriter", func() {
+	var logger *fakeTestLogger
+	var writer *TestLogWriter
+
+	BeforeEach(func() {
+		logger = &fakeTestLogger{}
+		writer = NewTestLogWriter(logger)
+	})
+
+	It("should log each complete line", func() {
+		writer.Write([]byte("abc"))
+		Expect(logger.Lines()).Should(BeEmpty())
+
+		writer.Write([]byte("def\nghi\n\njk"))
+		Expect(logger.Lines()).Should(Equal([]string{"abcdef", "ghi", ""}))
+
+		writer.Write([]byte("l\n"))
+		Expect(logger.Lines()).Should(Equal([]string{"abcdef", "ghi", "", "jkl"}))
+	})
+
+	It("should log a trailing partial line on Flush", func() {
+		writer.Write([]byte("abc\ndef"))
+		Expect(writer.Flush()).Should(Succeed())
+		Expect(logger.Lines()).Should(Equal([]string{"abc", "def"}))
+
+		Expect(writer.Flush()).Should(Succeed())
+		Expect(logger.Lines()).Should(HaveLen(2))
+	})
+
+	It("should be safe for concurrent use", func() {
+		wg := &sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					writer.Write([]byte(fmt.Sprintf("line %d-%d\n", i, j)))
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		Expect(logger.Lines()).Should(HaveLen(1000))
+		Expect(logger.Lines()).Should(ContainElement("line 3-42"))
+	})
+
+	It("should receive the output of a session, flushed when it exits", func() {
+		if runtime.GOOS == "windows" {
+			Skip("the session runs a shell script")
+		}
+
+		session, err := Start(exec.Command("sh", "-c", "echo hello; printf world"), writer, writer)
+		Expect(err).ShouldNot(HaveOccurred())
+		Eventually(session).Should(Exit(0))
+
+		Expect(logger.Lines()).Should(Equal([]string{"hello", "world"}))
+	})
+})
@@ -0,0 +1,56 @@
+package gexec
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+/*
+ValidateCommand checks the passed-in *exec.Cmd for common configuration mistakes before it is started.  It returns a
+descriptive error if:
+
+- the command has no Path, or no Args (Args[0] should be the command's name)
+- Dir is set but is not an existing directory
+- Path does not resolve to an executable, following exec.LookPath's rules.  A relative Path containing a separator is
+resolved relative to Dir, as it will be when the command runs.
+
+ValidateCommand does not execute anything.  Use it to surface setup errors with a clear message:
+
+	command := exec.Command(pathToBinary, "--flag")
+	Expect(gexec.ValidateCommand(command)).Should(Succeed())
+*/
+func ValidateCommand(cmd *exec.Cmd) error {
+	if cmd == nil {
+		return errors.New("command is nil")
+	}
+	if cmd.Path == "" {
+		return errors.New("command has no Path")
+	}
+	if len(cmd.Args) == 0 {
+		return fmt.Errorf("command %s has no Args: Args[0] should be the command's name", cmd.Path)
+	}
+
+	if cmd.Dir != "" {
+		info, err := os.Stat(cmd.Dir)
+		if err != nil {
+			return fmt.Errorf("command %s has an invalid Dir: %s", cmd.Path, err)
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("command %s has an invalid Dir: %s is not a directory", cmd.Path, cmd.Dir)
+		}
+	}
+
+	path := cmd.Path
+	if cmd.Dir != "" && !filepath.IsAbs(path) && strings.ContainsRune(path, os.PathSeparator) {
+		path = filepath.Join(cmd.Dir, path)
+	}
+	if _, err := exec.LookPath(path); err != nil {
+		return fmt.Errorf("command %s cannot be run: %s", cmd.Path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+// +build !windows
+
+package gexec_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("ValidateCommand", func() {
+	It("succeeds for a valid command", func() {
+		Expect(ValidateCommand(exec.Command("ls", "-l"))).To(Succeed())
+		Expect(ValidateCommand(exec.Command(fireflyPath))).To(Succeed())
+	})
+
+	It("fails for a missing binary", func() {
+		err := ValidateCommand(exec.Command("this-binary-does-not-exist"))
+		Expect(err).To(MatchError(ContainSubstring("command this-binary-does-not-exist cannot be run")))
+
+		err = ValidateCommand(exec.Command("/no/such/binary"))
+		Expect(err).To(MatchError(ContainSubstring("command /no/such/binary cannot be run")))
+	})
+
+	It("fails for a file that isn't executable", func() {
+		file, err := ioutil.TempFile("", "not-executable")
+		Expect(err).NotTo(HaveOccurred())
+		file.Close()
+		defer os.Remove(file.Name())
+
+		Expect(ValidateCommand(exec.Command(file.Name()))).To(MatchError(ContainSubstring("cannot be run")))
+	})
+
+	It("fails for a nonexistent working directory", func() {
+		command := exec.Command("ls")
+		command.Dir = "/no/such/dir"
+		Expect(ValidateCommand(command)).To(MatchError(ContainSubstring("has an invalid Dir")))
+	})
+
+	It("fails when the working directory is a file", func() {
+		command := exec.Command("ls")
+		command.Dir = fireflyPath
+		Expect(ValidateCommand(command)).To(MatchError(ContainSubstring("is not a directory")))
+	})
+
+	It("resolves a relative Path against Dir", func() {
+		command := exec.Command("./" + filepath.Base(fireflyPath))
+		command.Dir = filepath.Dir(fireflyPath)
+		Expect(ValidateCommand(command)).To(Succeed())
+	})
+
+	It("fails for an empty Path or Args", func() {
+		Expect(ValidateCommand(&exec.Cmd{})).To(MatchError("command has no Path"))
+		Expect(ValidateCommand(&exec.Cmd{Path: "/bin/ls"})).To(MatchError(ContainSubstring("has no Args")))
+		Expect(ValidateCommand(nil)).To(MatchError("command is nil"))
+	})
+})
@@ -305,10 +305,26 @@ func SetDefaultConsistentlyPollingInterval(t time.Duration) {
 //
 //   Eventually(myChannel).Should(Receive(), "Something should have come down the pipe.")
 //   Consistently(myChannel).ShouldNot(Receive(), func() string { return "Nothing should have come down the pipe." })
-type AsyncAssertion interface {
-	Should(matcher types.GomegaMatcher, optionalDescription ...interface{}) bool
-	ShouldNot(matcher types.GomegaMatcher, optionalDescription ...interface{}) bool
-}
+//
+// WithMinSamples guarantees that the matcher is checked at least n times, polling past the timeout if necessary.
+// This keeps a Consistently meaningful when the polling interval is coarse relative to its duration:
+//
+//   Consistently(session).WithMinSamples(5).ShouldNot(Exit())
+//
+// WithContext stops polling once the passed-in context is done.  A cancelled context fails the assertion:
+// neither Eventually nor Consistently has been satisfied if it was cut short.  The failure message includes the context's cause,
+// which is its error unless it was cancelled with context.WithCancelCause.
+//
+//   Eventually(client.Status).WithContext(ctx).Should(Equal("ready"))
+//
+// Within sets a latency budget for Eventually: the assertion fails if the matcher is only satisfied after the budget has
+// elapsed, even though that is still within the timeout.  The failure reports how long it actually took.  This guards
+// against performance regressions:
+//
+//   Eventually(server.Ready, 5*time.Second).Within(500 * time.Millisecond).Should(BeTrue())
+//
+// Within has no effect on Consistently.
+type AsyncAssertion = types.AsyncAssertion
 
 // GomegaAsyncAssertion is deprecated in favor of AsyncAssertion, which does not stutter.
 type GomegaAsyncAssertion = AsyncAssertion
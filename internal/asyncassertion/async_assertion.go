@@ -3,6 +3,7 @@
 package asyncassertion
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
@@ -26,6 +27,9 @@ type AsyncAssertion struct {
 	pollingInterval time.Duration
 	failWrapper     *types.GomegaFailWrapper
 	offset          int
+	minSamples      int
+	ctx             context.Context
+	budget          time.Duration
 }
 
 func New(asyncType AsyncAssertionType, actualInput interface{}, failWrapper *types.GomegaFailWrapper, timeoutInterval time.Duration, pollingInterval time.Duration, offset int) *AsyncAssertion {
@@ -46,6 +50,21 @@ func New(asyncType AsyncAssertionType, actualInput interface{}, failWrapper *typ
 	}
 }
 
+func (assertion *AsyncAssertion) WithMinSamples(n int) types.AsyncAssertion {
+	assertion.minSamples = n
+	return assertion
+}
+
+func (assertion *AsyncAssertion) WithContext(ctx context.Context) types.AsyncAssertion {
+	assertion.ctx = ctx
+	return assertion
+}
+
+func (assertion *AsyncAssertion) Within(budget time.Duration) types.AsyncAssertion {
+	assertion.budget = budget
+	return assertion
+}
+
 func (assertion *AsyncAssertion) Should(matcher types.GomegaMatcher, optionalDescription ...interface{}) bool {
 	assertion.failWrapper.TWithHelper.Helper()
 	return assertion.match(matcher, true, optionalDescription...)
@@ -105,15 +124,27 @@ func (assertion *AsyncAssertion) matcherMayChange(matcher types.GomegaMatcher, v
 func (assertion *AsyncAssertion) match(matcher types.GomegaMatcher, desiredMatch bool, optionalDescription ...interface{}) bool {
 	timer := time.Now()
 	timeout := time.After(assertion.timeoutInterval)
+	timedOut := false
 
+	var cancelled <-chan struct{}
+	if assertion.ctx != nil {
+		cancelled = assertion.ctx.Done()
+	}
+
+	var value interface{}
 	var matches bool
 	var err error
 	mayChange := true
-	value, err := assertion.pollActual()
-	if err == nil {
-		mayChange = assertion.matcherMayChange(matcher, value)
-		matches, err = matcher.Match(value)
+	samples := 0
+	poll := func() {
+		samples++
+		value, err = assertion.pollActual()
+		if err == nil {
+			mayChange = assertion.matcherMayChange(matcher, value)
+			matches, err = matcher.Match(value)
+		}
 	}
+	poll()
 
 	assertion.failWrapper.TWithHelper.Helper()
 
@@ -131,12 +162,18 @@ func (assertion *AsyncAssertion) match(matcher types.GomegaMatcher, desiredMatch
 		}
 		assertion.failWrapper.TWithHelper.Helper()
 		description := assertion.buildDescription(optionalDescription...)
-		assertion.failWrapper.Fail(fmt.Sprintf("%s after %.3fs.\n%s%s%s", preamble, time.Since(timer).Seconds(), description, message, errMsg), 3+assertion.offset)
+		assertion.failWrapper.Fail(fmt.Sprintf("%s after %.3fs (%s).\n%s%s%s", preamble, time.Since(timer).Seconds(), pluralizeSamples(samples), description, message, errMsg), 3+assertion.offset)
 	}
 
 	if assertion.asyncType == AsyncAssertionTypeEventually {
 		for {
 			if err == nil && matches == desiredMatch {
+				elapsed := time.Since(timer)
+				if assertion.budget > 0 && elapsed > assertion.budget {
+					description := assertion.buildDescription(optionalDescription...)
+					assertion.failWrapper.Fail(fmt.Sprintf("Succeeded after %.3fs (%s), exceeding the latency budget of %s.\n%s", elapsed.Seconds(), pluralizeSamples(samples), assertion.budget, description), 2+assertion.offset)
+					return false
+				}
 				return true
 			}
 
@@ -145,15 +182,19 @@ func (assertion *AsyncAssertion) match(matcher types.GomegaMatcher, desiredMatch
 				return false
 			}
 
+			if timedOut && samples >= assertion.minSamples {
+				fail("Timed out")
+				return false
+			}
+
 			select {
 			case <-time.After(assertion.pollingInterval):
-				value, err = assertion.pollActual()
-				if err == nil {
-					mayChange = assertion.matcherMayChange(matcher, value)
-					matches, err = matcher.Match(value)
-				}
+				poll()
 			case <-timeout:
-				fail("Timed out")
+				timedOut = true
+			case <-cancelled:
+				err = context.Cause(assertion.ctx)
+				fail("Context was cancelled")
 				return false
 			}
 		}
@@ -168,15 +209,19 @@ func (assertion *AsyncAssertion) match(matcher types.GomegaMatcher, desiredMatch
 				return true
 			}
 
+			if timedOut && samples >= assertion.minSamples {
+				return true
+			}
+
 			select {
 			case <-time.After(assertion.pollingInterval):
-				value, err = assertion.pollActual()
-				if err == nil {
-					mayChange = assertion.matcherMayChange(matcher, value)
-					matches, err = matcher.Match(value)
-				}
+				poll()
 			case <-timeout:
-				return true
+				timedOut = true
+			case <-cancelled:
+				err = context.Cause(assertion.ctx)
+				fail("Context was cancelled")
+				return false
 			}
 		}
 	}
@@ -184,6 +229,13 @@ func (assertion *AsyncAssertion) match(matcher types.GomegaMatcher, desiredMatch
 	return false
 }
 
+func pluralizeSamples(samples int) string {
+	if samples == 1 {
+		return "1 sample"
+	}
+	return fmt.Sprintf("%d samples", samples)
+}
+
 func vetExtras(extras []interface{}) (bool, string) {
 	for i, extra := range extras {
 		if extra != nil {
@@ -1,6 +1,7 @@
 package asyncassertion_test
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -230,6 +231,7 @@ var _ = Describe("Async Assertion", func() {
 
 					a.Should(Equal("foo"))
 					Expect(failureMessage).Should(ContainSubstring("to equal"))
+					Expect(failureMessage).Should(ContainSubstring("(6 samples)"))
 					Expect(callerSkip).Should(Equal(4))
 				})
 			})
@@ -386,4 +388,150 @@ var _ = Describe("Async Assertion", func() {
 			})
 		})
 	})
+
+	Describe("WithMinSamples", func() {
+		It("should keep Consistently polling past the duration until enough samples are taken", func() {
+			calls := 0
+			a := asyncassertion.New(asyncassertion.AsyncAssertionTypeConsistently, func() string {
+				calls++
+				return "foo"
+			}, fakeFailWrapper, 50*time.Millisecond, 100*time.Millisecond, 1)
+
+			t := time.Now()
+			a.WithMinSamples(3).Should(Equal("foo"))
+			Expect(failureMessage).Should(BeZero())
+			Expect(calls).Should(Equal(3))
+			Expect(time.Since(t)).Should(BeNumerically(">=", 200*time.Millisecond))
+		})
+
+		It("should still fail Consistently as soon as a sample fails", func() {
+			calls := 0
+			a := asyncassertion.New(asyncassertion.AsyncAssertionTypeConsistently, func() int {
+				calls++
+				return calls
+			}, fakeFailWrapper, 50*time.Millisecond, 20*time.Millisecond, 1)
+
+			a.WithMinSamples(10).Should(BeNumerically("<", 5))
+			Expect(calls).Should(Equal(5))
+			Expect(failureMessage).Should(ContainSubstring("Failed after"))
+			Expect(failureMessage).Should(ContainSubstring("(5 samples)"))
+		})
+
+		It("should keep Eventually polling past the timeout until enough samples are taken", func() {
+			calls := 0
+			a := asyncassertion.New(asyncassertion.AsyncAssertionTypeEventually, func() int {
+				calls++
+				return calls
+			}, fakeFailWrapper, 50*time.Millisecond, 100*time.Millisecond, 1)
+
+			a.WithMinSamples(3).Should(Equal(-1))
+			Expect(calls).Should(Equal(3))
+			Expect(failureMessage).Should(ContainSubstring("Timed out after"))
+			Expect(failureMessage).Should(ContainSubstring("(3 samples)"))
+			Expect(callerSkip).Should(Equal(4))
+		})
+
+		It("should not delay an Eventually that succeeds", func() {
+			calls := 0
+			a := asyncassertion.New(asyncassertion.AsyncAssertionTypeEventually, func() int {
+				calls++
+				return calls
+			}, fakeFailWrapper, 50*time.Millisecond, 100*time.Millisecond, 1)
+
+			a.WithMinSamples(3).Should(Equal(1))
+			Expect(calls).Should(Equal(1))
+			Expect(failureMessage).Should(BeZero())
+		})
+	})
+
+	Describe("WithContext", func() {
+		It("should fail Consistently when the context is cancelled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			go func() {
+				time.Sleep(50 * time.Millisecond)
+				cancel()
+			}()
+
+			a := asyncassertion.New(asyncassertion.AsyncAssertionTypeConsistently, func() string {
+				return "foo"
+			}, fakeFailWrapper, time.Second, 10*time.Millisecond, 1)
+
+			t := time.Now()
+			a.WithContext(ctx).Should(Equal("foo"))
+			Expect(time.Since(t)).Should(BeNumerically("<", 500*time.Millisecond))
+			Expect(failureMessage).Should(ContainSubstring("Context was cancelled after"))
+			Expect(failureMessage).Should(ContainSubstring("Error: context canceled"))
+			Expect(callerSkip).Should(Equal(4))
+		})
+
+		It("should fail Eventually when the context is cancelled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			a := asyncassertion.New(asyncassertion.AsyncAssertionTypeEventually, func() string {
+				return "foo"
+			}, fakeFailWrapper, time.Second, 10*time.Millisecond, 1)
+
+			t := time.Now()
+			a.WithContext(ctx).Should(Equal("bar"))
+			Expect(time.Since(t)).Should(BeNumerically("<", 500*time.Millisecond))
+			Expect(failureMessage).Should(ContainSubstring("Context was cancelled after"))
+			Expect(failureMessage).Should(ContainSubstring("Error: context canceled"))
+			Expect(callerSkip).Should(Equal(4))
+		})
+
+		It("should report the cause of a context cancelled with one", func() {
+			ctx, cancel := context.WithCancelCause(context.Background())
+			cancel(errors.New("the server crashed"))
+
+			a := asyncassertion.New(asyncassertion.AsyncAssertionTypeEventually, func() string {
+				return "foo"
+			}, fakeFailWrapper, time.Second, 10*time.Millisecond, 1)
+
+			a.WithContext(ctx).Should(Equal("bar"))
+			Expect(failureMessage).Should(ContainSubstring("Context was cancelled after"))
+			Expect(failureMessage).Should(ContainSubstring("Error: the server crashed"))
+		})
+
+		It("should not affect an assertion whose context is never cancelled", func() {
+			a := asyncassertion.New(asyncassertion.AsyncAssertionTypeConsistently, func() string {
+				return "foo"
+			}, fakeFailWrapper, 50*time.Millisecond, 10*time.Millisecond, 1)
+
+			a.WithContext(context.Background()).Should(Equal("foo"))
+			Expect(failureMessage).Should(BeZero())
+		})
+	})
+
+	Describe("Within", func() {
+		It("should pass when the condition becomes true just before the budget", func() {
+			start := time.Now()
+			a := asyncassertion.New(asyncassertion.AsyncAssertionTypeEventually, func() bool {
+				return time.Since(start) > 30*time.Millisecond
+			}, fakeFailWrapper, time.Second, 5*time.Millisecond, 1)
+
+			Expect(a.Within(150 * time.Millisecond).Should(BeTrue())).Should(BeTrue())
+			Expect(failureMessage).Should(BeZero())
+		})
+
+		It("should fail, reporting the elapsed time, when the condition becomes true just after the budget", func() {
+			start := time.Now()
+			a := asyncassertion.New(asyncassertion.AsyncAssertionTypeEventually, func() bool {
+				return time.Since(start) > 100*time.Millisecond
+			}, fakeFailWrapper, time.Second, 5*time.Millisecond, 1)
+
+			Expect(a.Within(50*time.Millisecond).Should(BeTrue(), "server should be ready")).Should(BeFalse())
+			Expect(failureMessage).Should(MatchRegexp(`^Succeeded after \d+\.\d{3}s \(\d+ samples\), exceeding the latency budget of 50ms\.\nserver should be ready\n$`))
+			Expect(callerSkip).Should(Equal(3))
+		})
+
+		It("should still time out if the condition never becomes true", func() {
+			a := asyncassertion.New(asyncassertion.AsyncAssertionTypeEventually, func() bool {
+				return false
+			}, fakeFailWrapper, 100*time.Millisecond, 10*time.Millisecond, 1)
+
+			a.Within(50 * time.Millisecond).Should(BeTrue())
+			Expect(failureMessage).Should(ContainSubstring("Timed out after"))
+		})
+	})
 })
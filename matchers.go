@@ -1,6 +1,8 @@
 package gomega
 
 import (
+	"fmt"
+	"path"
 	"time"
 
 	"github.com/onsi/gomega/matchers"
@@ -16,6 +18,20 @@ func Equal(expected interface{}) types.GomegaMatcher {
 	}
 }
 
+//EqualIgnoringFields is like Equal, but first sets the named fields to their zero values on both actual and expected.
+//This is useful for comparing structs that carry volatile fields such as timestamps or IDs:
+//
+//    Expect(record).Should(EqualIgnoringFields(expectedRecord, "ID", "Metadata.CreatedAt"))
+//
+//Nested fields are named with dotted paths; pointers along the path are followed, and neither actual nor expected is modified.
+//Actual and expected must have the same type, and it is an error to name a field that does not exist or is unexported.
+func EqualIgnoringFields(expected interface{}, fields ...string) types.GomegaMatcher {
+	return &matchers.EqualIgnoringFieldsMatcher{
+		Expected: expected,
+		Fields:   fields,
+	}
+}
+
 //BeEquivalentTo is more lax than Equal, allowing equality between different types.
 //This is done by converting actual to have the type of expected before
 //attempting equality with reflect.DeepEqual.
@@ -86,6 +102,21 @@ func MatchError(expected interface{}) types.GomegaMatcher {
 	}
 }
 
+//MatchErrorOfType succeeds if actual is an error whose chain contains an error that can be assigned to target,
+//as determined by errors.As.  Target must be a non-nil pointer to a type implementing error, or to an interface.
+//On success the matching error is stored in target, ready for further assertions:
+//
+//    var opErr *net.OpError
+//    Expect(err).Should(MatchErrorOfType(&opErr))
+//    Expect(opErr.Op).Should(Equal("dial"))
+//
+//The failure message lists every error in the chain.
+func MatchErrorOfType(target interface{}) types.GomegaMatcher {
+	return &matchers.MatchErrorOfTypeMatcher{
+		Target: target,
+	}
+}
+
 //BeClosed succeeds if actual is a closed channel.
 //It is an error to pass a non-channel to BeClosed, it is also an error to pass nil
 //
@@ -136,7 +167,15 @@ func BeClosed() types.GomegaMatcher {
 //    Eventually(thingChan).Should(Receive(&myThing))
 //    Expect(myThing.Sprocket).Should(Equal("foo"))
 //    Expect(myThing.IsValid()).Should(BeTrue())
-func Receive(args ...interface{}) types.GomegaMatcher {
+//
+//To wait for a value without reaching for Eventually, call WithTimeout on the matcher:
+//    Expect(c).Should(Receive(&myThing).WithTimeout(time.Second))
+//
+//This blocks for up to a second until a value arrives on `c`.  It fails as soon as `c` is closed and fails if the timeout
+//elapses with nothing received; the failure message tells the two cases apart.  Unlike Eventually(c).Should(Receive(...)),
+//which keeps pulling values until one matches, WithTimeout receives exactly one value and matches it against the passed-in matcher.
+//Expect(c).ShouldNot(Receive().WithTimeout(d)) asserts that nothing is sent to `c` for d.
+func Receive(args ...interface{}) *matchers.ReceiveMatcher {
 	var arg interface{}
 	if len(args) > 0 {
 		arg = args[0]
@@ -165,6 +204,21 @@ func BeSent(arg interface{}) types.GomegaMatcher {
 	}
 }
 
+//MatchGlob succeeds if actual is a string or stringer that matches the passed-in glob pattern in its entirety.
+//The pattern syntax is that of path.Match: '*' matches any run of non-'/' characters, '?' matches a single non-'/'
+//character, '[...]' matches a character class and '\' escapes the next character.
+//    Expect(artifactName).Should(MatchGlob("build-*.tar.gz"))
+//
+//MatchGlob panics if the pattern is malformed.
+func MatchGlob(pattern string) types.GomegaMatcher {
+	if _, err := path.Match(pattern, ""); err != nil {
+		panic(fmt.Sprintf("MatchGlob was passed an invalid glob pattern %q: %s", pattern, err))
+	}
+	return &matchers.MatchGlobMatcher{
+		Pattern: pattern,
+	}
+}
+
 //MatchRegexp succeeds if actual is a string or stringer that matches the
 //passed-in regexp.  Optional arguments can be provided to construct a regexp
 //via fmt.Sprintf().
@@ -175,6 +229,37 @@ func MatchRegexp(regexp string, args ...interface{}) types.GomegaMatcher {
 	}
 }
 
+//MatchFormat succeeds if actual is a string or stringer that is valid according to the named format.
+//The built-in formats are "uuid", "url", "email", "ip", "ipv4" and "ipv6"; RegisterFormat adds more.
+//On failure, the message explains why the value is invalid:
+//    Expect(session.Out.Contents()).Should(MatchFormat("email"))
+func MatchFormat(name string) types.GomegaMatcher {
+	return &matchers.MatchFormatMatcher{
+		Format: name,
+	}
+}
+
+//BeValidUUID succeeds if actual is a string or stringer holding a hyphenated, hexadecimal UUID.
+//It is shorthand for MatchFormat("uuid").
+func BeValidUUID() types.GomegaMatcher {
+	return MatchFormat("uuid")
+}
+
+//BeValidURL succeeds if actual is a string or stringer holding a URL with a scheme and, unless it
+//is a file: or opaque URL, a host.  It is shorthand for MatchFormat("url").
+func BeValidURL() types.GomegaMatcher {
+	return MatchFormat("url")
+}
+
+//RegisterFormat adds a named format for use with MatchFormat.  The validator returns nil
+//for valid strings and otherwise an error describing what is wrong:
+//    RegisterFormat("semver", func(s string) error { ... })
+//    Expect(version).Should(MatchFormat("semver"))
+//Registering an existing name replaces its validator.
+func RegisterFormat(name string, validator func(string) error) {
+	matchers.RegisterFormatValidator(name, validator)
+}
+
 //ContainSubstring succeeds if actual is a string or stringer that contains the
 //passed-in substring.  Optional arguments can be provided to construct the substring
 //via fmt.Sprintf().
@@ -238,7 +323,11 @@ func BeEmpty() types.GomegaMatcher {
 }
 
 //HaveLen succeeds if actual has the passed-in length.  Actual must be of type string, array, map, chan, or slice.
-func HaveLen(count int) types.GomegaMatcher {
+//
+//Instead of an int, a matcher can be passed in.  It is applied to the length, and the failure message
+//shows both the collection and its length:
+//    Expect(events).Should(HaveLen(BeNumerically(">=", 3)))
+func HaveLen(count interface{}) types.GomegaMatcher {
 	return &matchers.HaveLenMatcher{
 		Count: count,
 	}
@@ -252,10 +341,26 @@ func HaveCap(count int) types.GomegaMatcher {
 }
 
 //BeZero succeeds if actual is the zero value for its type or if actual is nil.
+//It works across types: "", 0, false, nil pointers and zero-valued structs are all zero.
+//
+//Slices and maps are only zero when they are nil.  An empty but non-nil slice or map is
+//distinguishable from nil (it marshals to [] rather than null, for example) so BeZero rejects it.
+//Use BeZeroOrEmpty to accept both.
+//    Expect(result.Err).Should(BeZero())
+//    Expect([]int(nil)).Should(BeZero())
+//    Expect([]int{}).ShouldNot(BeZero())
 func BeZero() types.GomegaMatcher {
 	return &matchers.BeZeroMatcher{}
 }
 
+//BeZeroOrEmpty is like BeZero, but also succeeds for empty, non-nil slices and maps.
+//    Expect([]int{}).Should(BeZeroOrEmpty())
+func BeZeroOrEmpty() types.GomegaMatcher {
+	return &matchers.BeZeroMatcher{
+		EmptyIsZero: true,
+	}
+}
+
 //ContainElement succeeds if actual contains the passed in element.
 //By default ContainElement() uses Equal() to perform the match, however a
 //matcher can be passed in instead:
@@ -263,9 +368,18 @@ func BeZero() types.GomegaMatcher {
 //
 //Actual must be an array, slice or map.
 //For maps, ContainElement searches through the map's values.
-func ContainElement(element interface{}) types.GomegaMatcher {
+//
+//If you want to have a reference to the matching element you can pass ContainElement a pointer to a variable of
+//the appropriate type as an optional second argument.  The first matching element is stored in it:
+//    var found string
+//    Expect([]string{"Foo", "FooBar"}).Should(ContainElement(ContainSubstring("Bar"), &found))
+//    Expect(found).Should(Equal("FooBar"))
+//
+//It is an error for the element type of actual not to be assignable to the type pointed to.
+func ContainElement(element interface{}, result ...interface{}) types.GomegaMatcher {
 	return &matchers.ContainElementMatcher{
 		Element: element,
+		Result:  result,
 	}
 }
 
@@ -306,6 +420,22 @@ func ConsistOf(elements ...interface{}) types.GomegaMatcher {
 	}
 }
 
+//BeAPermutationOf succeeds if actual contains exactly the same elements as expected, each the same number of times, in any order.
+//Elements are compared with reflect.DeepEqual.
+//    Expect([]int{3, 1, 2, 1}).Should(BeAPermutationOf([]int{1, 1, 2, 3}))
+//    Expect([]int{3, 1, 2, 2}).ShouldNot(BeAPermutationOf([]int{1, 1, 2, 3}))
+//
+//Unlike ConsistOf, BeAPermutationOf takes a single array or slice and treats its elements as plain values, never as matchers,
+//so it always answers "are these the same bag of values?" - including when the elements are themselves matchers or slices.
+//On failure it lists each element that appears a different number of times in actual and expected.
+//
+//Both actual and expected must be arrays or slices.
+func BeAPermutationOf(expected interface{}) types.GomegaMatcher {
+	return &matchers.BeAPermutationOfMatcher{
+		Expected: expected,
+	}
+}
+
 //ContainElements succeeds if actual contains the passed in elements. The ordering of the elements does not matter.
 //By default ContainElements() uses Equal() to match the elements, however custom matchers can be passed in instead. Here are some examples:
 //
@@ -320,6 +450,22 @@ func ContainElements(elements ...interface{}) types.GomegaMatcher {
 	}
 }
 
+//ContainElementsInOrder succeeds if actual contains the passed in elements in the given relative order.
+//Other elements may appear between them.  Like ContainElements, it uses Equal() to match the elements
+//unless custom matchers are passed in:
+//
+//    Expect([]string{"start", "connect", "retry", "ready"}).Should(ContainElementsInOrder("start", "ready"))
+//    Expect([]string{"start", "connect", "retry", "ready"}).Should(ContainElementsInOrder(HavePrefix("con"), "ready"))
+//    Expect([]string{"start", "connect", "retry", "ready"}).ShouldNot(ContainElementsInOrder("ready", "start"))
+//
+//Actual must be an array or slice.  The failure message reports the first element of the sequence
+//that could not be found after its predecessor.
+func ContainElementsInOrder(elements ...interface{}) types.GomegaMatcher {
+	return &matchers.ContainElementsInOrderMatcher{
+		Elements: elements,
+	}
+}
+
 //HaveKey succeeds if actual is a map with the passed in key.
 //By default HaveKey uses Equal() to perform the match, however a
 //matcher can be passed in instead:
@@ -360,10 +506,43 @@ func BeNumerically(comparator string, compareTo ...interface{}) types.GomegaMatc
 	}
 }
 
+//BeNumericallyCloseTo succeeds if actual is a slice or array of numbers with the same length
+//as expected and every element is within tolerance of the corresponding expected element.
+//On failure it reports the first index that diverges and by how much.
+//    Expect([]float64{1.0001, 2.0}).Should(BeNumericallyCloseTo([]float64{1.0, 2.0}, 0.001))
+//
+//NaN is considered close to NaN, and an infinity is only close to an infinity of the same sign.
+//For a single number, use BeNumerically("~", expected, tolerance).
+func BeNumericallyCloseTo(expected []float64, tolerance float64) types.GomegaMatcher {
+	return &matchers.BeNumericallyCloseToMatcher{
+		Expected:  expected,
+		Tolerance: tolerance,
+	}
+}
+
+//BeRelativelyCloseTo succeeds if actual is a number whose relative error from expected,
+//|actual - expected| / |expected|, is at most relativeTolerance.  Unlike BeNumerically("~", ...) a single tolerance
+//works across very different magnitudes:
+//    Expect(1.0001e12).Should(BeRelativelyCloseTo(1e12, 1e-3))
+//    Expect(1.0001e-12).Should(BeRelativelyCloseTo(1e-12, 1e-3))
+//
+//The relative error is undefined when expected is zero, so in that case BeRelativelyCloseTo falls back to an absolute
+//comparison and succeeds if |actual| <= relativeTolerance.  An infinity is only close to an infinity of the same sign,
+//and NaN is never close to anything.  The failure message reports the relative error.
+func BeRelativelyCloseTo(expected float64, relativeTolerance float64) types.GomegaMatcher {
+	return &matchers.BeRelativelyCloseToMatcher{
+		Expected:          expected,
+		RelativeTolerance: relativeTolerance,
+	}
+}
+
 //BeTemporally compares time.Time's like BeNumerically
 //Actual and expected must be time.Time. The comparators are the same as for BeNumerically
 //    Expect(time.Now()).Should(BeTemporally(">", time.Time{}))
 //    Expect(time.Now()).Should(BeTemporally("~", time.Now(), time.Second))
+//
+//"~" succeeds if |actual - expected| <= threshold and requires the threshold to be passed in.
+//Its failure message reports the difference between the two times.
 func BeTemporally(comparator string, compareTo time.Time, threshold ...time.Duration) types.GomegaMatcher {
 	return &matchers.BeTemporallyMatcher{
 		Comparator: comparator,
@@ -384,6 +563,47 @@ func BeAssignableToTypeOf(expected interface{}) types.GomegaMatcher {
 	}
 }
 
+//BeDeterministic succeeds if actual is a function that returns deep-equal results each time it is called.
+//Actual must be a function that takes no arguments and returns one or more values; it is called iterations times
+//and every result is compared against the first with reflect.DeepEqual.  Use it to catch nondeterminism such as
+//map iteration order leaking into output:
+//    Expect(func() []string { return registry.Names() }).Should(BeDeterministic(20))
+//
+//On failure the first call that differed is reported, along with its result and the first call's result.
+func BeDeterministic(iterations int) types.GomegaMatcher {
+	return &matchers.BeDeterministicMatcher{
+		Iterations: iterations,
+	}
+}
+
+//CompleteWithin succeeds if actual is a function that, when invoked, returns within duration.
+//Actual must be a function that takes no arguments; any values it returns are ignored.
+//    Expect(func() { cache.Get("key") }).Should(CompleteWithin(100 * time.Millisecond))
+//
+//The function is called exactly once, on a separate goroutine, so CompleteWithin measures the latency of a single
+//call rather than polling a condition - use it with Expect, not Eventually.  A function that panics is an error.
+//
+//Go provides no way to stop a goroutine from the outside: if the function never returns, its goroutine is leaked
+//and keeps running after the assertion fails.
+func CompleteWithin(duration time.Duration) types.GomegaMatcher {
+	return &matchers.CompleteWithinMatcher{
+		Duration: duration,
+	}
+}
+
+//ImplementInterface succeeds if the dynamic type of actual implements an interface.  Pass the interface as a nil pointer
+//to the interface type:
+//    Expect(NewStore()).Should(ImplementInterface((*io.ReadWriter)(nil)))
+//
+//Method sets follow Go's rules, so a struct value whose methods have pointer receivers does not implement the interface
+//but a pointer to it does - the failure message points this out.  A nil actual has no dynamic type and never implements
+//an interface.  On failure the interface's missing methods are listed.
+func ImplementInterface(iface interface{}) types.GomegaMatcher {
+	return &matchers.ImplementInterfaceMatcher{
+		Interface: iface,
+	}
+}
+
 //Panic succeeds if actual is a function that, when invoked, panics.
 //Actual must be a function that takes no arguments and returns no results.
 func Panic() types.GomegaMatcher {
@@ -432,6 +652,16 @@ func SatisfyAll(matchers ...types.GomegaMatcher) types.GomegaMatcher {
 	return And(matchers...)
 }
 
+//AllOf succeeds only if all of the given matchers succeed.
+//Unlike And(), AllOf does not fail-fast: every matcher is evaluated and the failure message lists each one that failed.
+//  Expect("hi").To(AllOf(HaveLen(3), Equal("hip"), HavePrefix("h")))
+//
+//This makes AllOf a good fit for complex assertions where you want to see everything that is wrong in a single run,
+//at the cost of always evaluating every matcher.
+func AllOf(ms ...types.GomegaMatcher) types.GomegaMatcher {
+	return &matchers.AllOfMatcher{Matchers: ms}
+}
+
 //Or succeeds if any of the given matchers succeed.
 //The matchers are tried in order and will return immediately upon the first successful match.
 //  Expect("hi").To(Or(HaveLen(3), HaveLen(2))
@@ -0,0 +1,77 @@
+package matchers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/onsi/gomega/format"
+	"github.com/onsi/gomega/internal/oraclematcher"
+	"github.com/onsi/gomega/types"
+)
+
+type AllOfMatcher struct {
+	Matchers []types.GomegaMatcher
+
+	// state
+	failedMatchers []types.GomegaMatcher
+	failures       []string
+}
+
+func (m *AllOfMatcher) Match(actual interface{}) (success bool, err error) {
+	m.failedMatchers = nil
+	m.failures = nil
+	hasErrors := false
+	for _, matcher := range m.Matchers {
+		success, err := matcher.Match(actual)
+		if err != nil {
+			hasErrors = true
+			m.failedMatchers = append(m.failedMatchers, matcher)
+			m.failures = append(m.failures, "Error: "+err.Error())
+		} else if !success {
+			m.failedMatchers = append(m.failedMatchers, matcher)
+			m.failures = append(m.failures, matcher.FailureMessage(actual))
+		}
+	}
+
+	if hasErrors {
+		return false, errors.New(m.summarizeFailures())
+	}
+	return len(m.failedMatchers) == 0, nil
+}
+
+func (m *AllOfMatcher) summarizeFailures() string {
+	summary := fmt.Sprintf("%d of %d matchers failed:", len(m.failures), len(m.Matchers))
+	for i, failure := range m.failures {
+		summary += fmt.Sprintf("\n[%d] %s", i+1, strings.TrimPrefix(format.IndentString(failure, 1), format.Indent))
+	}
+	return summary
+}
+
+func (m *AllOfMatcher) FailureMessage(actual interface{}) (message string) {
+	return m.summarizeFailures()
+}
+
+func (m *AllOfMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	return format.Message(actual, fmt.Sprintf("To not satisfy all of these matchers: %s", m.Matchers))
+}
+
+func (m *AllOfMatcher) MatchMayChangeInTheFuture(actual interface{}) bool {
+	if len(m.failedMatchers) == 0 {
+		// if everything succeeded, any matcher changing would change the result.
+		for _, matcher := range m.Matchers {
+			if oraclematcher.MatchMayChangeInTheFuture(matcher, actual) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// otherwise every failed matcher has to change for the result to change.
+	for _, matcher := range m.failedMatchers {
+		if !oraclematcher.MatchMayChangeInTheFuture(matcher, actual) {
+			return false
+		}
+	}
+	return true
+}
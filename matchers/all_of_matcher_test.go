@@ -0,0 +1,117 @@
+package matchers_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/matchers"
+)
+
+var _ = Describe("AllOfMatcher", func() {
+	It("works with positive cases", func() {
+		Expect(input).To(AllOf())
+		Expect(input).To(AllOf(true1))
+		Expect(input).To(AllOf(true1, true2))
+		Expect(input).To(AllOf(true1, true2, true3))
+	})
+
+	It("works with negative cases", func() {
+		Expect(input).ToNot(AllOf(false1, false2))
+		Expect(input).ToNot(AllOf(true1, true2, false3))
+		Expect(input).ToNot(AllOf(false1, true1, true2))
+	})
+
+	It("evaluates every matcher, even after one fails", func() {
+		first := &callCountingMatcher{}
+		last := &callCountingMatcher{}
+		Expect(input).ToNot(AllOf(first, false1, last))
+		Expect(first.calls).To(Equal(1))
+		Expect(last.calls).To(Equal(1))
+	})
+
+	Context("failure messages", func() {
+		When("multiple matchers fail", func() {
+			It("lists every failure", func() {
+				m := AllOf(false1, true1, false2, false3)
+				Expect(m.Match(input)).To(BeFalse())
+				Expect(m.FailureMessage(input)).To(Equal(
+					"3 of 4 matchers failed:\n" +
+						"[1] Expected\n        <string>: hi\n    to have length 1\n" +
+						"[2] Expected\n        <string>: hi\n    to equal\n        <string>: hip\n" +
+						"[3] Expected\n        <string>: hi\n    to match regular expression\n        <string>: hope"))
+			})
+		})
+
+		When("a matcher errors", func() {
+			It("returns an error listing every failure", func() {
+				success, err := AllOf(false1, BeNumerically(">", 1)).Match(input)
+				Expect(success).To(BeFalse())
+				Expect(err).To(MatchError(ContainSubstring("2 of 2 matchers failed:")))
+				Expect(err).To(MatchError(ContainSubstring("[1] Expected\n        <string>: hi\n    to have length 1")))
+				Expect(err).To(MatchError(ContainSubstring("[2] Error: Expected a number.")))
+			})
+		})
+
+		When("match succeeds, but expected it to fail", func() {
+			It("gives a descriptive message", func() {
+				verifyFailureMessage(Not(AllOf(true1, true2)), input,
+					`To not satisfy all of these matchers: [%!s(*matchers.HaveLenMatcher=&{2}) %!s(*matchers.EqualMatcher=&{hi})]`)
+			})
+		})
+	})
+
+	Context("MatchMayChangeInTheFuture", func() {
+		It("depends only on the failed matchers when the match fails", func() {
+			m := AllOf(Not(BeNil()), Equal(1), Equal(2))
+			Expect(m.Match("hi")).To(BeFalse())
+			Expect(m.(*AllOfMatcher).MatchMayChangeInTheFuture("hi")).To(BeTrue()) // Equal(1) and Equal(2) may both change
+
+			m = AllOf(Not(BeNil()), Or())
+			Expect(m.Match("hi")).To(BeFalse())
+			Expect(m.(*AllOfMatcher).MatchMayChangeInTheFuture("hi")).To(BeFalse()) // empty Or() indicates not going to change
+		})
+
+		It("will not change when any one failed matcher will not change", func() {
+			m := AllOf(Not(BeNil()), Or(), Equal(1))
+			Expect(m.Match("hi")).To(BeFalse())
+			Expect(m.(*AllOfMatcher).MatchMayChangeInTheFuture("hi")).To(BeFalse()) // Equal(1) may change, but empty Or() never will
+		})
+
+		It("lets Eventually bail out early when a failed matcher will never change", func() {
+			buffer := gbytes.NewBuffer()
+			buffer.Close()
+
+			t := time.Now()
+			failures := InterceptGomegaFailures(func() {
+				Eventually(buffer, 5).Should(AllOf(gbytes.Say("never"), Not(BeNil())))
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(time.Since(t)).To(BeNumerically("<", time.Second))
+		})
+
+		It("depends on every matcher when the match succeeds", func() {
+			m := AllOf(Not(BeNil()), Not(Or()))
+			Expect(m.Match("hi")).To(BeTrue())
+			Expect(m.(*AllOfMatcher).MatchMayChangeInTheFuture("hi")).To(BeTrue()) // Not(BeNil()) may change
+		})
+	})
+})
+
+type callCountingMatcher struct {
+	calls int
+}
+
+func (m *callCountingMatcher) Match(actual interface{}) (bool, error) {
+	m.calls++
+	return true, nil
+}
+
+func (m *callCountingMatcher) FailureMessage(actual interface{}) string {
+	return "unexpected failure"
+}
+
+func (m *callCountingMatcher) NegatedFailureMessage(actual interface{}) string {
+	return "unexpected negated failure"
+}
@@ -0,0 +1,70 @@
+package matchers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/onsi/gomega/format"
+)
+
+type BeAPermutationOfMatcher struct {
+	Expected interface{}
+
+	countDifferences []string
+}
+
+type elementCount struct {
+	element  interface{}
+	actual   int
+	expected int
+}
+
+func (matcher *BeAPermutationOfMatcher) Match(actual interface{}) (success bool, err error) {
+	if !isArrayOrSlice(actual) {
+		return false, fmt.Errorf("BeAPermutationOf matcher expects an array or slice.  Got:\n%s", format.Object(actual, 1))
+	}
+	if !isArrayOrSlice(matcher.Expected) {
+		return false, fmt.Errorf("BeAPermutationOf matcher must be passed an array or slice.  Got:\n%s", format.Object(matcher.Expected, 1))
+	}
+
+	counts := []*elementCount{}
+	countFor := func(element interface{}) *elementCount {
+		for _, count := range counts {
+			if reflect.DeepEqual(count.element, element) {
+				return count
+			}
+		}
+		count := &elementCount{element: element}
+		counts = append(counts, count)
+		return count
+	}
+
+	for _, element := range valuesOf(actual) {
+		countFor(element).actual++
+	}
+	for _, element := range valuesOf(matcher.Expected) {
+		countFor(element).expected++
+	}
+
+	matcher.countDifferences = []string{}
+	for _, count := range counts {
+		if count.actual != count.expected {
+			matcher.countDifferences = append(matcher.countDifferences, fmt.Sprintf("%s\nappears %d time(s) in actual and %d time(s) in expected", format.Object(count.element, 1), count.actual, count.expected))
+		}
+	}
+
+	return len(matcher.countDifferences) == 0, nil
+}
+
+func (matcher *BeAPermutationOfMatcher) FailureMessage(actual interface{}) (message string) {
+	message = format.Message(actual, "to be a permutation of", matcher.Expected)
+	if len(matcher.countDifferences) > 0 {
+		message += "\nThe following elements appear a different number of times:\n" + strings.Join(matcher.countDifferences, "\n")
+	}
+	return message
+}
+
+func (matcher *BeAPermutationOfMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	return format.Message(actual, "not to be a permutation of", matcher.Expected)
+}
@@ -0,0 +1,54 @@
+package matchers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/matchers"
+)
+
+var _ = Describe("BeAPermutationOf", func() {
+	It("should succeed when the elements and their multiplicities match, in any order", func() {
+		Expect([]int{3, 1, 2, 1}).Should(BeAPermutationOf([]int{1, 1, 2, 3}))
+		Expect([3]string{"b", "a", "a"}).Should(BeAPermutationOf([]string{"a", "a", "b"}))
+		Expect([]int{}).Should(BeAPermutationOf([]int{}))
+		Expect([][]int{{2}, {1}}).Should(BeAPermutationOf([][]int{{1}, {2}}))
+	})
+
+	It("should fail when an element appears a different number of times", func() {
+		Expect([]int{1, 2, 2, 3}).ShouldNot(BeAPermutationOf([]int{1, 1, 2, 3}))
+		Expect([]string{"a", "b"}).ShouldNot(BeAPermutationOf([]string{"a", "c"}))
+	})
+
+	It("should fail when the lengths differ", func() {
+		Expect([]int{1, 2, 3}).ShouldNot(BeAPermutationOf([]int{1, 2}))
+		Expect([]int{1, 2}).ShouldNot(BeAPermutationOf([]int{1, 2, 2}))
+	})
+
+	It("should be strict about element types and never treat elements as matchers", func() {
+		Expect([]interface{}{1, "1"}).ShouldNot(BeAPermutationOf([]interface{}{1, 1}))
+		Expect([]interface{}{"foo"}).ShouldNot(BeAPermutationOf([]interface{}{Equal("foo")}))
+	})
+
+	It("should report the elements that appear a different number of times", func() {
+		matcher := BeAPermutationOf([]int{1, 1, 2, 3})
+		Expect(matcher.Match([]int{1, 2, 2, 3})).Should(BeFalse())
+
+		message := matcher.FailureMessage([]int{1, 2, 2, 3})
+		Expect(message).Should(ContainSubstring("to be a permutation of"))
+		Expect(message).Should(ContainSubstring("The following elements appear a different number of times:\n    <int>: 1\nappears 1 time(s) in actual and 2 time(s) in expected\n    <int>: 2\nappears 2 time(s) in actual and 1 time(s) in expected"))
+		Expect(message).ShouldNot(ContainSubstring("<int>: 3\n"))
+	})
+
+	Context("when passed something that is not an array or slice", func() {
+		It("should error", func() {
+			_, err := (&BeAPermutationOfMatcher{Expected: []int{1}}).Match(map[int]int{1: 1})
+			Expect(err).Should(HaveOccurred())
+
+			_, err = (&BeAPermutationOfMatcher{Expected: 1}).Match([]int{1})
+			Expect(err).Should(HaveOccurred())
+
+			_, err = (&BeAPermutationOfMatcher{Expected: []int{1}}).Match(nil)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
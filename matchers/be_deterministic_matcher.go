@@ -0,0 +1,65 @@
+package matchers
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/onsi/gomega/format"
+)
+
+type BeDeterministicMatcher struct {
+	Iterations int
+
+	first         interface{}
+	differing     interface{}
+	differingCall int
+}
+
+func (matcher *BeDeterministicMatcher) Match(actual interface{}) (success bool, err error) {
+	if matcher.Iterations < 2 {
+		return false, fmt.Errorf("BeDeterministic needs at least 2 iterations to compare.  Got:\n%s", format.Object(matcher.Iterations, 1))
+	}
+	if actual == nil {
+		return false, fmt.Errorf("BeDeterministic expects a non-nil actual.")
+	}
+
+	actualType := reflect.TypeOf(actual)
+	if actualType.Kind() != reflect.Func || actualType.NumIn() != 0 || actualType.NumOut() == 0 {
+		return false, fmt.Errorf("BeDeterministic expects a function with no arguments and one or more return values.  Got:\n%s", format.Object(actual, 1))
+	}
+
+	matcher.first = callForResult(actual)
+	for call := 2; call <= matcher.Iterations; call++ {
+		result := callForResult(actual)
+		if !reflect.DeepEqual(matcher.first, result) {
+			matcher.differing = result
+			matcher.differingCall = call
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// callForResult calls f and returns its result, or a slice of its results if it returns more than one value.
+func callForResult(f interface{}) interface{} {
+	values := reflect.ValueOf(f).Call([]reflect.Value{})
+	if len(values) == 1 {
+		return values[0].Interface()
+	}
+	results := make([]interface{}, len(values))
+	for i, value := range values {
+		results[i] = value.Interface()
+	}
+	return results
+}
+
+func (matcher *BeDeterministicMatcher) FailureMessage(actual interface{}) (message string) {
+	return fmt.Sprintf("Expected function to return the same result on each of %d calls, but call %d differed from call 1:\n%s",
+		matcher.Iterations, matcher.differingCall, format.Message(matcher.differing, "to equal", matcher.first))
+}
+
+func (matcher *BeDeterministicMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	return fmt.Sprintf("Expected function not to return the same result on each of %d calls, but it did:\n%s",
+		matcher.Iterations, format.Object(matcher.first, 1))
+}
@@ -0,0 +1,73 @@
+package matchers_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/matchers"
+)
+
+var _ = Describe("BeDeterministic", func() {
+	mapKeys := func() []int {
+		m := map[int]bool{}
+		for i := 0; i < 20; i++ {
+			m[i] = true
+		}
+		keys := []int{}
+		for key := range m {
+			keys = append(keys, key)
+		}
+		return keys
+	}
+
+	It("should succeed for a deterministic function", func() {
+		Expect(func() []string { return []string{"a", "b"} }).Should(BeDeterministic(10))
+		Expect(func() (map[string]int, error) { return map[string]int{"a": 1, "b": 2}, nil }).Should(BeDeterministic(10))
+	})
+
+	It("should fail for a function leaking map iteration order, reporting the differing call", func() {
+		matcher := &BeDeterministicMatcher{Iterations: 100}
+		success, err := matcher.Match(mapKeys)
+		Expect(success).Should(BeFalse())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(matcher.FailureMessage(mapKeys)).Should(MatchRegexp(`^Expected function to return the same result on each of 100 calls, but call \d+ differed from call 1:\nExpected\n    <\[\]int \| len:20, cap:\d+>: \[`))
+	})
+
+	It("should compare every return value", func() {
+		calls := 0
+		f := func() (string, error) {
+			calls++
+			if calls == 3 {
+				return "same", errors.New("boom")
+			}
+			return "same", nil
+		}
+
+		matcher := &BeDeterministicMatcher{Iterations: 5}
+		Expect(matcher.Match(f)).Should(BeFalse())
+		Expect(matcher.FailureMessage(f)).Should(ContainSubstring("call 3 differed from call 1"))
+	})
+
+	It("should call the function the requested number of times", func() {
+		calls := 0
+		Expect(func() int { calls++; return 1 }).Should(BeDeterministic(7))
+		Expect(calls).Should(Equal(7))
+	})
+
+	Context("when passed invalid arguments", func() {
+		It("should error", func() {
+			_, err := (&BeDeterministicMatcher{Iterations: 1}).Match(func() int { return 1 })
+			Expect(err).Should(HaveOccurred())
+
+			_, err = (&BeDeterministicMatcher{Iterations: 2}).Match(func() {})
+			Expect(err).Should(HaveOccurred())
+
+			_, err = (&BeDeterministicMatcher{Iterations: 2}).Match(func(int) int { return 1 })
+			Expect(err).Should(HaveOccurred())
+
+			_, err = (&BeDeterministicMatcher{Iterations: 2}).Match(nil)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,81 @@
+package matchers
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/onsi/gomega/format"
+)
+
+type BeNumericallyCloseToMatcher struct {
+	Expected  []float64
+	Tolerance float64
+}
+
+func (matcher *BeNumericallyCloseToMatcher) Match(actual interface{}) (success bool, err error) {
+	if matcher.Tolerance < 0 || math.IsNaN(matcher.Tolerance) {
+		return false, fmt.Errorf("BeNumericallyCloseTo requires a non-negative tolerance.  Got:\n%s", format.Object(matcher.Tolerance, 1))
+	}
+	values, ok := toFloatSlice(actual)
+	if !ok {
+		return false, fmt.Errorf("BeNumericallyCloseTo matcher expects a slice or array of numbers.  Got:\n%s", format.Object(actual, 1))
+	}
+	return matcher.divergence(values) == "", nil
+}
+
+func (matcher *BeNumericallyCloseToMatcher) FailureMessage(actual interface{}) (message string) {
+	values, _ := toFloatSlice(actual)
+	return format.Message(actual, fmt.Sprintf("to be elementwise within %v of", matcher.Tolerance), matcher.Expected) + "\n" + matcher.divergence(values)
+}
+
+func (matcher *BeNumericallyCloseToMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	return format.Message(actual, fmt.Sprintf("not to be elementwise within %v of", matcher.Tolerance), matcher.Expected)
+}
+
+// divergence describes the first way in which actual differs from the expected
+// values, or returns the empty string if every element is within tolerance.
+func (matcher *BeNumericallyCloseToMatcher) divergence(actual []float64) string {
+	if len(actual) != len(matcher.Expected) {
+		return fmt.Sprintf("Lengths differ: actual has %d elements, expected has %d", len(actual), len(matcher.Expected))
+	}
+	for i := range actual {
+		a, e := actual[i], matcher.Expected[i]
+		if closeTo(a, e, matcher.Tolerance) {
+			continue
+		}
+		return fmt.Sprintf("First divergence at index %d: %v differs from %v by %v", i, a, e, math.Abs(a-e))
+	}
+	return ""
+}
+
+// closeTo treats NaN as equal to NaN and an infinity as equal only to an
+// infinity of the same sign.
+func closeTo(actual, expected, tolerance float64) bool {
+	switch {
+	case math.IsNaN(actual) || math.IsNaN(expected):
+		return math.IsNaN(actual) && math.IsNaN(expected)
+	case math.IsInf(actual, 0) || math.IsInf(expected, 0):
+		return actual == expected
+	}
+	return math.Abs(actual-expected) <= tolerance
+}
+
+func toFloatSlice(a interface{}) ([]float64, bool) {
+	if a == nil {
+		return nil, false
+	}
+	value := reflect.ValueOf(a)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return nil, false
+	}
+	values := make([]float64, value.Len())
+	for i := range values {
+		element := value.Index(i).Interface()
+		if !isNumber(element) {
+			return nil, false
+		}
+		values[i] = toFloat(element)
+	}
+	return values, true
+}
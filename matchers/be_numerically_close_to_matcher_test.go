@@ -0,0 +1,79 @@
+package matchers_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/matchers"
+)
+
+var _ = Describe("BeNumericallyCloseTo", func() {
+	When("the elements are within tolerance", func() {
+		It("should succeed", func() {
+			Expect([]float64{1.0001, 2.0, -3.0}).Should(BeNumericallyCloseTo([]float64{1.0, 2.0001, -3.0}, 0.001))
+			Expect([]float64{}).Should(BeNumericallyCloseTo([]float64{}, 0))
+			Expect([]float32{1.5, 2.5}).Should(BeNumericallyCloseTo([]float64{1.5, 2.5}, 0))
+			Expect([2]int{1, 2}).Should(BeNumericallyCloseTo([]float64{1.1, 1.9}, 0.2))
+		})
+	})
+
+	When("a single element diverges", func() {
+		It("should fail and report the first divergent index", func() {
+			matcher := BeNumericallyCloseTo([]float64{1.0, 2.0, 3.0}, 0.01)
+			actual := []float64{1.0, 2.5, 4.0}
+			Expect(actual).ShouldNot(matcher)
+
+			message := matcher.FailureMessage(actual)
+			Expect(message).Should(ContainSubstring("to be elementwise within 0.01 of"))
+			Expect(message).Should(HaveSuffix("First divergence at index 1: 2.5 differs from 2 by 0.5"))
+		})
+	})
+
+	When("the lengths differ", func() {
+		It("should fail and report the lengths", func() {
+			matcher := BeNumericallyCloseTo([]float64{1.0, 2.0}, 0.01)
+			actual := []float64{1.0, 2.0, 3.0}
+			Expect(actual).ShouldNot(matcher)
+			Expect(matcher.FailureMessage(actual)).Should(HaveSuffix("Lengths differ: actual has 3 elements, expected has 2"))
+		})
+	})
+
+	Context("with NaN and Inf", func() {
+		It("should treat NaN as close to NaN", func() {
+			Expect([]float64{math.NaN()}).Should(BeNumericallyCloseTo([]float64{math.NaN()}, 0))
+			Expect([]float64{math.NaN()}).ShouldNot(BeNumericallyCloseTo([]float64{0}, 1))
+			Expect([]float64{0}).ShouldNot(BeNumericallyCloseTo([]float64{math.NaN()}, 1))
+		})
+
+		It("should only match an infinity of the same sign", func() {
+			Expect([]float64{math.Inf(1)}).Should(BeNumericallyCloseTo([]float64{math.Inf(1)}, 0))
+			Expect([]float64{math.Inf(1)}).ShouldNot(BeNumericallyCloseTo([]float64{math.Inf(-1)}, 0))
+			Expect([]float64{math.MaxFloat64}).ShouldNot(BeNumericallyCloseTo([]float64{math.Inf(1)}, math.MaxFloat64))
+		})
+	})
+
+	When("passed something that isn't a slice of numbers", func() {
+		It("should error", func() {
+			success, err := (&BeNumericallyCloseToMatcher{Expected: []float64{1}, Tolerance: 0}).Match(1.0)
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(HaveOccurred())
+
+			success, err = (&BeNumericallyCloseToMatcher{Expected: []float64{1}, Tolerance: 0}).Match([]string{"a"})
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(HaveOccurred())
+
+			success, err = (&BeNumericallyCloseToMatcher{Expected: []float64{1}, Tolerance: 0}).Match(nil)
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	When("passed a negative tolerance", func() {
+		It("should error", func() {
+			success, err := (&BeNumericallyCloseToMatcher{Expected: []float64{1}, Tolerance: -1}).Match([]float64{1})
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(MatchError(ContainSubstring("non-negative tolerance")))
+		})
+	})
+})
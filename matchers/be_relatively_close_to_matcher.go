@@ -0,0 +1,61 @@
+package matchers
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/onsi/gomega/format"
+)
+
+type BeRelativelyCloseToMatcher struct {
+	Expected          float64
+	RelativeTolerance float64
+}
+
+func (matcher *BeRelativelyCloseToMatcher) Match(actual interface{}) (success bool, err error) {
+	if matcher.RelativeTolerance < 0 || math.IsNaN(matcher.RelativeTolerance) {
+		return false, fmt.Errorf("BeRelativelyCloseTo requires a non-negative relative tolerance.  Got:\n%s", format.Object(matcher.RelativeTolerance, 1))
+	}
+	if !isNumber(actual) {
+		return false, fmt.Errorf("BeRelativelyCloseTo expects a number.  Got:\n%s", format.Object(actual, 1))
+	}
+
+	return matcher.relativeError(toFloat(actual)) <= matcher.RelativeTolerance, nil
+}
+
+func (matcher *BeRelativelyCloseToMatcher) FailureMessage(actual interface{}) (message string) {
+	return format.Message(actual, fmt.Sprintf("to be within a relative tolerance of %v of", matcher.RelativeTolerance), matcher.Expected) + "\n" + matcher.explanation(actual)
+}
+
+func (matcher *BeRelativelyCloseToMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	return format.Message(actual, fmt.Sprintf("not to be within a relative tolerance of %v of", matcher.RelativeTolerance), matcher.Expected) + "\n" + matcher.explanation(actual)
+}
+
+// relativeError returns |actual - expected| / |expected|, or |actual| when expected is zero.
+// Infinities are only close to an infinity of the same sign; anything involving NaN is never close.
+func (matcher *BeRelativelyCloseToMatcher) relativeError(actual float64) float64 {
+	if math.IsInf(matcher.Expected, 0) || math.IsInf(actual, 0) {
+		if actual == matcher.Expected {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	if matcher.Expected == 0 {
+		return math.Abs(actual)
+	}
+	relativeError := math.Abs(actual-matcher.Expected) / math.Abs(matcher.Expected)
+	if math.IsNaN(relativeError) {
+		return math.Inf(1)
+	}
+	return relativeError
+}
+
+func (matcher *BeRelativelyCloseToMatcher) explanation(actual interface{}) string {
+	if !isNumber(actual) {
+		return ""
+	}
+	if matcher.Expected == 0 {
+		return fmt.Sprintf("expected is zero, so the absolute difference |actual| was used instead: %v", matcher.relativeError(toFloat(actual)))
+	}
+	return fmt.Sprintf("the relative error |actual - expected| / |expected| is %v", matcher.relativeError(toFloat(actual)))
+}
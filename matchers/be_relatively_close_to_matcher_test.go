@@ -0,0 +1,65 @@
+package matchers_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/matchers"
+)
+
+var _ = Describe("BeRelativelyCloseTo", func() {
+	It("should compare relative to the magnitude of expected", func() {
+		Expect(1.0001e12).Should(BeRelativelyCloseTo(1e12, 1e-3))
+		Expect(1.01e12).ShouldNot(BeRelativelyCloseTo(1e12, 1e-3))
+
+		Expect(1.0001e-12).Should(BeRelativelyCloseTo(1e-12, 1e-3))
+		Expect(1.01e-12).ShouldNot(BeRelativelyCloseTo(1e-12, 1e-3))
+
+		Expect(-99.95).Should(BeRelativelyCloseTo(-100, 1e-3))
+		Expect(99.95).ShouldNot(BeRelativelyCloseTo(-100, 1e-3))
+	})
+
+	It("should accept any numeric type for actual", func() {
+		Expect(1000).Should(BeRelativelyCloseTo(1001, 1e-2))
+		Expect(uint8(100)).Should(BeRelativelyCloseTo(101, 1e-1))
+		Expect(float32(2.5)).Should(BeRelativelyCloseTo(2.5, 0))
+	})
+
+	It("should fall back to an absolute comparison when expected is zero", func() {
+		Expect(0.0).Should(BeRelativelyCloseTo(0, 0))
+		Expect(1e-4).Should(BeRelativelyCloseTo(0, 1e-3))
+		Expect(-1e-4).Should(BeRelativelyCloseTo(0, 1e-3))
+		Expect(1e-2).ShouldNot(BeRelativelyCloseTo(0, 1e-3))
+
+		message := BeRelativelyCloseTo(0, 1e-3).FailureMessage(0.5)
+		Expect(message).Should(ContainSubstring("expected is zero, so the absolute difference |actual| was used instead: 0.5"))
+	})
+
+	It("should handle infinities and NaN", func() {
+		Expect(math.Inf(1)).Should(BeRelativelyCloseTo(math.Inf(1), 0))
+		Expect(math.Inf(-1)).ShouldNot(BeRelativelyCloseTo(math.Inf(1), 1))
+		Expect(1e300).ShouldNot(BeRelativelyCloseTo(math.Inf(1), 1))
+		Expect(math.NaN()).ShouldNot(BeRelativelyCloseTo(1, 1))
+	})
+
+	It("should report the relative error", func() {
+		message := BeRelativelyCloseTo(100, 1e-3).FailureMessage(101.0)
+		Expect(message).Should(ContainSubstring("to be within a relative tolerance of 0.001 of"))
+		Expect(message).Should(ContainSubstring("the relative error |actual - expected| / |expected| is 0.01"))
+	})
+
+	Context("when passed invalid arguments", func() {
+		It("should error", func() {
+			success, err := (&BeRelativelyCloseToMatcher{Expected: 1, RelativeTolerance: -1}).Match(1.0)
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(HaveOccurred())
+
+			_, err = (&BeRelativelyCloseToMatcher{Expected: 1, RelativeTolerance: 0.1}).Match("1")
+			Expect(err).Should(HaveOccurred())
+
+			_, err = (&BeRelativelyCloseToMatcher{Expected: 1, RelativeTolerance: 0.1}).Match(nil)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
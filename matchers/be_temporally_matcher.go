@@ -16,11 +16,21 @@ type BeTemporallyMatcher struct {
 }
 
 func (matcher *BeTemporallyMatcher) FailureMessage(actual interface{}) (message string) {
-	return format.Message(actual, fmt.Sprintf("to be %s", matcher.Comparator), matcher.CompareTo)
+	message = format.Message(actual, fmt.Sprintf("to be %s", matcher.Comparator), matcher.CompareTo)
+	return message + matcher.describeDelta(actual, "exceeds")
 }
 
 func (matcher *BeTemporallyMatcher) NegatedFailureMessage(actual interface{}) (message string) {
-	return format.Message(actual, fmt.Sprintf("not to be %s", matcher.Comparator), matcher.CompareTo)
+	message = format.Message(actual, fmt.Sprintf("not to be %s", matcher.Comparator), matcher.CompareTo)
+	return message + matcher.describeDelta(actual, "is within")
+}
+
+func (matcher *BeTemporallyMatcher) describeDelta(actual interface{}, relation string) string {
+	actualTime, ok := actual.(time.Time)
+	if matcher.Comparator != "~" || !ok || len(matcher.Threshold) != 1 {
+		return ""
+	}
+	return fmt.Sprintf("\nthe difference (actual - expected) is %s, which %s the threshold of %s", actualTime.Sub(matcher.CompareTo), relation, matcher.Threshold[0])
 }
 
 func (matcher *BeTemporallyMatcher) Match(actual interface{}) (bool, error) {
@@ -40,8 +50,11 @@ func (matcher *BeTemporallyMatcher) Match(actual interface{}) (bool, error) {
 		return false, fmt.Errorf("Unknown comparator: %s", matcher.Comparator)
 	}
 
-	var threshold = time.Millisecond
-	if len(matcher.Threshold) == 1 {
+	var threshold time.Duration
+	if matcher.Comparator == "~" {
+		if len(matcher.Threshold) != 1 {
+			return false, fmt.Errorf("BeTemporally(\"~\", ...) requires exactly one threshold, e.g. BeTemporally(\"~\", expected, time.Second).  Got %d.", len(matcher.Threshold))
+		}
 		threshold = matcher.Threshold[0]
 	}
 
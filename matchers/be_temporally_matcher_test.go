@@ -52,14 +52,34 @@ var _ = Describe("BeTemporally", func() {
 
 		When("passed ~", func() {
 			Context("and there is no precision parameter", func() {
-				BeforeEach(func() {
-					t1 = t0.Add(time.Millisecond / 2)
-					t2 = t0.Add(-2 * time.Millisecond)
+				It("should error", func() {
+					success, err := (&BeTemporallyMatcher{Comparator: "~", CompareTo: t0}).Match(t0)
+					Expect(success).Should(BeFalse())
+					Expect(err).Should(MatchError(ContainSubstring(`BeTemporally("~", ...) requires exactly one threshold`)))
+				})
+			})
+
+			Context("and there is more than one precision parameter", func() {
+				It("should error", func() {
+					success, err := (&BeTemporallyMatcher{Comparator: "~", CompareTo: t0, Threshold: []time.Duration{time.Second, time.Minute}}).Match(t0)
+					Expect(success).Should(BeFalse())
+					Expect(err).Should(HaveOccurred())
 				})
-				It("should approximate", func() {
-					Expect(t0).Should(BeTemporally("~", t0))
-					Expect(t0).Should(BeTemporally("~", t1))
-					Expect(t0).ShouldNot(BeTemporally("~", t2))
+			})
+
+			Context("at and beyond the threshold", func() {
+				It("should include the threshold itself", func() {
+					d := 200 * time.Millisecond
+					Expect(t0.Add(d)).Should(BeTemporally("~", t0, d))
+					Expect(t0.Add(-d)).Should(BeTemporally("~", t0, d))
+					Expect(t0.Add(d + time.Nanosecond)).ShouldNot(BeTemporally("~", t0, d))
+					Expect(t0.Add(-d - time.Nanosecond)).ShouldNot(BeTemporally("~", t0, d))
+				})
+
+				It("should report the difference and the threshold", func() {
+					matcher := BeTemporally("~", t0, 200*time.Millisecond)
+					Expect(matcher.FailureMessage(t0.Add(350 * time.Millisecond))).Should(HaveSuffix("\nthe difference (actual - expected) is 350ms, which exceeds the threshold of 200ms"))
+					Expect(matcher.NegatedFailureMessage(t0.Add(-5 * time.Millisecond))).Should(HaveSuffix("\nthe difference (actual - expected) is -5ms, which is within the threshold of 200ms"))
 				})
 			})
 
@@ -7,22 +7,33 @@ import (
 )
 
 type BeZeroMatcher struct {
+	//EmptyIsZero makes empty, non-nil slices and maps count as zero-valued
+	EmptyIsZero bool
 }
 
 func (matcher *BeZeroMatcher) Match(actual interface{}) (success bool, err error) {
 	if actual == nil {
 		return true, nil
 	}
-	zeroValue := reflect.Zero(reflect.TypeOf(actual)).Interface()
 
-	return reflect.DeepEqual(zeroValue, actual), nil
+	if matcher.EmptyIsZero {
+		value := reflect.ValueOf(actual)
+		if (value.Kind() == reflect.Slice || value.Kind() == reflect.Map) && value.Len() == 0 {
+			return true, nil
+		}
+	}
 
+	return reflect.DeepEqual(zeroValueOf(actual), actual), nil
 }
 
 func (matcher *BeZeroMatcher) FailureMessage(actual interface{}) (message string) {
-	return format.Message(actual, "to be zero-valued")
+	return format.Message(actual, "to be zero-valued, i.e. equal to", zeroValueOf(actual))
 }
 
 func (matcher *BeZeroMatcher) NegatedFailureMessage(actual interface{}) (message string) {
 	return format.Message(actual, "not to be zero-valued")
 }
+
+func zeroValueOf(actual interface{}) interface{} {
+	return reflect.Zero(reflect.TypeOf(actual)).Interface()
+}
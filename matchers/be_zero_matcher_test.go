@@ -1,6 +1,8 @@
 package matchers_test
 
 import (
+	"errors"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -18,19 +20,34 @@ var _ = Describe("BeZero", func() {
 		Expect(0.0).Should(BeZero())
 		Expect(0.1).ShouldNot(BeZero())
 
-		// Expect([]int{}).Should(BeZero())
+		Expect(false).Should(BeZero())
+		Expect(true).ShouldNot(BeZero())
+
+		Expect([]int(nil)).Should(BeZero())
+		Expect([]int{}).ShouldNot(BeZero())
 		Expect([]int{1}).ShouldNot(BeZero())
 
-		// Expect(map[string]int{}).Should(BeZero())
+		Expect(map[string]int(nil)).Should(BeZero())
+		Expect(map[string]int{}).ShouldNot(BeZero())
 		Expect(map[string]int{"a": 1}).ShouldNot(BeZero())
 
 		Expect(myCustomType{}).Should(BeZero())
 		Expect(myCustomType{s: "a"}).ShouldNot(BeZero())
 	})
 
+	It("succeeds for nil pointers and interfaces", func() {
+		var pointer *myCustomType
+		Expect(pointer).Should(BeZero())
+		Expect(&myCustomType{}).ShouldNot(BeZero())
+
+		var err error
+		Expect(err).Should(BeZero())
+		Expect(errors.New("boom")).ShouldNot(BeZero())
+	})
+
 	It("builds failure message", func() {
 		actual := BeZero().FailureMessage(123)
-		Expect(actual).To(Equal("Expected\n    <int>: 123\nto be zero-valued"))
+		Expect(actual).To(Equal("Expected\n    <int>: 123\nto be zero-valued, i.e. equal to\n    <int>: 0"))
 	})
 
 	It("builds negated failure message", func() {
@@ -38,3 +55,21 @@ var _ = Describe("BeZero", func() {
 		Expect(actual).To(Equal("Expected\n    <int>: 123\nnot to be zero-valued"))
 	})
 })
+
+var _ = Describe("BeZeroOrEmpty", func() {
+	It("succeeds for zero values and empty slices and maps", func() {
+		Expect(nil).Should(BeZeroOrEmpty())
+		Expect("").Should(BeZeroOrEmpty())
+		Expect(0).Should(BeZeroOrEmpty())
+		Expect(myCustomType{}).Should(BeZeroOrEmpty())
+
+		Expect([]int(nil)).Should(BeZeroOrEmpty())
+		Expect([]int{}).Should(BeZeroOrEmpty())
+		Expect([]int{1}).ShouldNot(BeZeroOrEmpty())
+
+		Expect(map[string]int{}).Should(BeZeroOrEmpty())
+		Expect(map[string]int{"a": 1}).ShouldNot(BeZeroOrEmpty())
+
+		Expect(" ").ShouldNot(BeZeroOrEmpty())
+	})
+})
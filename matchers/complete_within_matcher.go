@@ -0,0 +1,53 @@
+package matchers
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/onsi/gomega/format"
+)
+
+type CompleteWithinMatcher struct {
+	Duration time.Duration
+	elapsed  time.Duration
+}
+
+func (matcher *CompleteWithinMatcher) Match(actual interface{}) (success bool, err error) {
+	if actual == nil {
+		return false, fmt.Errorf("CompleteWithinMatcher expects a non-nil actual.")
+	}
+
+	actualType := reflect.TypeOf(actual)
+	if actualType.Kind() != reflect.Func || actualType.NumIn() != 0 {
+		return false, fmt.Errorf("CompleteWithinMatcher expects a function with no arguments.  Got:\n%s", format.Object(actual, 1))
+	}
+
+	done := make(chan interface{}, 1)
+	start := time.Now()
+	go func() {
+		defer func() {
+			done <- recover()
+		}()
+		reflect.ValueOf(actual).Call([]reflect.Value{})
+	}()
+
+	select {
+	case panicked := <-done:
+		matcher.elapsed = time.Since(start)
+		if panicked != nil {
+			return false, fmt.Errorf("CompleteWithinMatcher's function panicked with:\n%s", format.Object(panicked, 1))
+		}
+		return true, nil
+	case <-time.After(matcher.Duration):
+		return false, nil
+	}
+}
+
+func (matcher *CompleteWithinMatcher) FailureMessage(actual interface{}) (message string) {
+	return fmt.Sprintf("Expected function to complete within %s, but it was still running", matcher.Duration)
+}
+
+func (matcher *CompleteWithinMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	return fmt.Sprintf("Expected function not to complete within %s, but it returned after %s", matcher.Duration, matcher.elapsed)
+}
@@ -0,0 +1,58 @@
+package matchers_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/matchers"
+)
+
+var _ = Describe("CompleteWithin", func() {
+	It("should succeed when the function returns in time", func() {
+		Expect(func() {}).Should(CompleteWithin(100 * time.Millisecond))
+		Expect(func() (int, error) { return 1, nil }).Should(CompleteWithin(100 * time.Millisecond))
+	})
+
+	It("should fail, reporting that the function is still running, when it is too slow", func() {
+		release := make(chan struct{})
+		defer close(release)
+
+		matcher := &CompleteWithinMatcher{Duration: 50 * time.Millisecond}
+		t := time.Now()
+		success, err := matcher.Match(func() { <-release })
+		Expect(time.Since(t)).Should(BeNumerically("<", 500*time.Millisecond))
+		Expect(success).Should(BeFalse())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(matcher.FailureMessage(nil)).Should(Equal("Expected function to complete within 50ms, but it was still running"))
+	})
+
+	It("should report the elapsed time when negated", func() {
+		matcher := &CompleteWithinMatcher{Duration: time.Second}
+		success, err := matcher.Match(func() {})
+		Expect(success).Should(BeTrue())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(matcher.NegatedFailureMessage(nil)).Should(MatchRegexp(`^Expected function not to complete within 1s, but it returned after \S+$`))
+	})
+
+	Context("when the function panics", func() {
+		It("should error", func() {
+			success, err := (&CompleteWithinMatcher{Duration: time.Second}).Match(func() { panic("boom") })
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(MatchError(ContainSubstring("boom")))
+		})
+	})
+
+	Context("when actual is not a function with no arguments", func() {
+		It("should error", func() {
+			_, err := (&CompleteWithinMatcher{Duration: time.Second}).Match(nil)
+			Expect(err).Should(HaveOccurred())
+
+			_, err = (&CompleteWithinMatcher{Duration: time.Second}).Match("foo")
+			Expect(err).Should(HaveOccurred())
+
+			_, err = (&CompleteWithinMatcher{Duration: time.Second}).Match(func(int) {})
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -3,6 +3,7 @@
 package matchers
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -11,6 +12,7 @@ import (
 
 type ContainElementMatcher struct {
 	Element interface{}
+	Result  []interface{}
 }
 
 func (matcher *ContainElementMatcher) Match(actual interface{}) (success bool, err error) {
@@ -18,32 +20,48 @@ func (matcher *ContainElementMatcher) Match(actual interface{}) (success bool, e
 		return false, fmt.Errorf("ContainElement matcher expects an array/slice/map.  Got:\n%s", format.Object(actual, 1))
 	}
 
+	var result reflect.Value
+	if len(matcher.Result) > 1 {
+		return false, errors.New("ContainElement matcher expects at most a single optional pointer to store the matching element in")
+	} else if len(matcher.Result) == 1 {
+		result, err = matcher.resultValue(actual)
+		if err != nil {
+			return false, err
+		}
+	}
+
 	elemMatcher, elementIsMatcher := matcher.Element.(omegaMatcher)
 	if !elementIsMatcher {
 		elemMatcher = &EqualMatcher{Expected: matcher.Element}
 	}
 
 	value := reflect.ValueOf(actual)
-	var valueAt func(int) interface{}
+	var valueAt func(int) reflect.Value
 	if isMap(actual) {
 		keys := value.MapKeys()
-		valueAt = func(i int) interface{} {
-			return value.MapIndex(keys[i]).Interface()
+		valueAt = func(i int) reflect.Value {
+			return value.MapIndex(keys[i])
 		}
 	} else {
-		valueAt = func(i int) interface{} {
-			return value.Index(i).Interface()
+		valueAt = func(i int) reflect.Value {
+			return value.Index(i)
 		}
 	}
 
 	var lastError error
 	for i := 0; i < value.Len(); i++ {
-		success, err := elemMatcher.Match(valueAt(i))
+		element := valueAt(i)
+		success, err := elemMatcher.Match(element.Interface())
 		if err != nil {
 			lastError = err
 			continue
 		}
 		if success {
+			if result.IsValid() {
+				if err := matcher.storeResult(result, element); err != nil {
+					return false, err
+				}
+			}
 			return true, nil
 		}
 	}
@@ -51,6 +69,31 @@ func (matcher *ContainElementMatcher) Match(actual interface{}) (success bool, e
 	return false, lastError
 }
 
+func (matcher *ContainElementMatcher) resultValue(actual interface{}) (reflect.Value, error) {
+	resultPointer := reflect.ValueOf(matcher.Result[0])
+	if resultPointer.Kind() != reflect.Ptr || resultPointer.IsNil() {
+		return reflect.Value{}, fmt.Errorf("ContainElement matcher expects a non-nil pointer to store the matching element in.  Got:\n%s", format.Object(matcher.Result[0], 1))
+	}
+
+	result := resultPointer.Elem()
+	elementType := reflect.TypeOf(actual).Elem()
+	if !elementType.AssignableTo(result.Type()) && elementType.Kind() != reflect.Interface {
+		return reflect.Value{}, fmt.Errorf("ContainElement cannot store the matching element because %s is not assignable to %s", elementType, result.Type())
+	}
+	return result, nil
+}
+
+func (matcher *ContainElementMatcher) storeResult(result reflect.Value, element reflect.Value) error {
+	if element.Kind() == reflect.Interface && !element.IsNil() && !element.Type().AssignableTo(result.Type()) {
+		element = element.Elem()
+	}
+	if !element.Type().AssignableTo(result.Type()) {
+		return fmt.Errorf("ContainElement cannot store the matching element because %s is not assignable to %s", element.Type(), result.Type())
+	}
+	result.Set(element)
+	return nil
+}
+
 func (matcher *ContainElementMatcher) FailureMessage(actual interface{}) (message string) {
 	return format.Message(actual, "to contain element matching", matcher.Element)
 }
@@ -1,6 +1,8 @@
 package matchers_test
 
 import (
+	"fmt"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/matchers"
@@ -48,6 +50,83 @@ var _ = Describe("ContainElement", func() {
 		})
 	})
 
+	When("passed a pointer to store the matching element in", func() {
+		It("should store the first matching element in a concrete type", func() {
+			arr := []myCustomType{
+				{s: "foo", n: 1},
+				{s: "bar", n: 2},
+				{s: "bar", n: 3},
+			}
+			var found myCustomType
+			Expect(arr).Should(ContainElement(WithTransform(func(m myCustomType) string { return m.s }, Equal("bar")), &found))
+			Expect(found).Should(Equal(myCustomType{s: "bar", n: 2}))
+		})
+
+		It("should store the first matching element through a struct pointer", func() {
+			arr := []*myCustomType{{s: "foo", n: 1}, {s: "bar", n: 2}}
+			var found *myCustomType
+			Expect(arr).Should(ContainElement(arr[1], &found))
+			Expect(found).Should(BeIdenticalTo(arr[1]))
+		})
+
+		It("should store the matching element in an interface", func() {
+			var found interface{}
+			Expect([]int{1, 2, 3}).Should(ContainElement(BeNumerically(">", 1), &found))
+			Expect(found).Should(Equal(2))
+
+			var stringer fmt.Stringer
+			Expect([]*myStringer{{a: "foo"}, {a: "bar"}}).Should(ContainElement(WithTransform(func(s *myStringer) string { return s.a }, Equal("bar")), &stringer))
+			Expect(stringer.String()).Should(Equal("bar"))
+		})
+
+		It("should unwrap interface elements into a concrete type", func() {
+			var found string
+			Expect([]interface{}{1, "foo", "bar"}).Should(ContainElement(HavePrefix("b"), &found))
+			Expect(found).Should(Equal("bar"))
+		})
+
+		It("should store matching map values", func() {
+			var found int
+			Expect(map[string]int{"foo": 1, "bar": 2}).Should(ContainElement(BeNumerically(">", 1), &found))
+			Expect(found).Should(Equal(2))
+		})
+
+		It("should leave the pointer untouched if nothing matches", func() {
+			found := 42
+			Expect([]int{1, 2, 3}).ShouldNot(ContainElement(4, &found))
+			Expect(found).Should(Equal(42))
+		})
+
+		It("should error if the element type is not assignable to the pointer", func() {
+			var found string
+			success, err := (&ContainElementMatcher{Element: 2, Result: []interface{}{&found}}).Match([]int{1, 2})
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(MatchError("ContainElement cannot store the matching element because int is not assignable to string"))
+
+			success, err = (&ContainElementMatcher{Element: 1, Result: []interface{}{&found}}).Match([]interface{}{1, 2})
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(MatchError("ContainElement cannot store the matching element because int is not assignable to string"))
+		})
+
+		It("should error if not passed a non-nil pointer", func() {
+			var found int
+			success, err := (&ContainElementMatcher{Element: 2, Result: []interface{}{found}}).Match([]int{1, 2})
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(HaveOccurred())
+
+			success, err = (&ContainElementMatcher{Element: 2, Result: []interface{}{(*int)(nil)}}).Match([]int{1, 2})
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should error if passed more than one pointer", func() {
+			var found, other int
+			success, err := (&ContainElementMatcher{Element: 2, Result: []interface{}{&found, &other}}).Match([]int{1, 2})
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
 	When("passed a correctly typed nil", func() {
 		It("should operate succesfully on the passed in value", func() {
 			var nilSlice []int
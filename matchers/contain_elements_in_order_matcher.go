@@ -0,0 +1,60 @@
+package matchers
+
+import (
+	"fmt"
+
+	"github.com/onsi/gomega/format"
+)
+
+type ContainElementsInOrderMatcher struct {
+	Elements []interface{}
+
+	missingElement      interface{}
+	missingElementIndex int
+	lastMatchIndex      int
+}
+
+func (matcher *ContainElementsInOrderMatcher) Match(actual interface{}) (success bool, err error) {
+	if !isArrayOrSlice(actual) {
+		return false, fmt.Errorf("ContainElementsInOrder matcher expects an array/slice.  Got:\n%s", format.Object(actual, 1))
+	}
+
+	values := valuesOf(actual)
+	elementMatchers := matchers(matcher.Elements)
+
+	cursor := 0
+	matcher.lastMatchIndex = -1
+	for i, elementMatcher := range elementMatchers {
+		found := false
+		for ; cursor < len(values); cursor++ {
+			if match, _ := neighbours(values[cursor], elementMatcher); match {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			matcher.missingElementIndex = i
+			matcher.missingElement = equalMatchersToElements([]interface{}{elementMatcher})[0]
+			return false, nil
+		}
+
+		matcher.lastMatchIndex = cursor
+		cursor++
+	}
+
+	return true, nil
+}
+
+func (matcher *ContainElementsInOrderMatcher) FailureMessage(actual interface{}) (message string) {
+	message = format.Message(actual, "to contain elements in order", matcher.Elements)
+	message = fmt.Sprintf("%s\nthe sequence broke at element %d:\n%s\n", message, matcher.missingElementIndex, format.Object(matcher.missingElement, 1))
+	if matcher.missingElementIndex == 0 {
+		return message + "which was not found"
+	}
+	return message + fmt.Sprintf("which was not found after index %d, where element %d matched", matcher.lastMatchIndex, matcher.missingElementIndex-1)
+}
+
+func (matcher *ContainElementsInOrderMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	return format.Message(actual, "not to contain elements in order", matcher.Elements)
+}
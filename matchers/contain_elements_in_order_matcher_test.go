@@ -0,0 +1,65 @@
+package matchers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ContainElementsInOrder", func() {
+	events := []string{"start", "connect", "retry", "connect", "ready", "stop"}
+
+	Context("with elements in order", func() {
+		It("should succeed", func() {
+			Expect(events).Should(ContainElementsInOrder("start", "connect", "retry", "connect", "ready", "stop"))
+			Expect(events).Should(ContainElementsInOrder("start"))
+			Expect(events).Should(ContainElementsInOrder())
+			Expect([3]int{1, 2, 3}).Should(ContainElementsInOrder(1, 3))
+		})
+	})
+
+	Context("with gaps between the elements", func() {
+		It("should succeed", func() {
+			Expect(events).Should(ContainElementsInOrder("start", "ready"))
+			Expect(events).Should(ContainElementsInOrder("connect", "connect", "stop"))
+		})
+	})
+
+	Context("with elements out of order", func() {
+		It("should fail", func() {
+			Expect(events).ShouldNot(ContainElementsInOrder("ready", "start"))
+			Expect(events).ShouldNot(ContainElementsInOrder("retry", "retry"))
+			Expect(events).ShouldNot(ContainElementsInOrder("start", "missing"))
+		})
+
+		It("should report the element that could not be found after its predecessor", func() {
+			matcher := ContainElementsInOrder("start", "ready", "connect")
+			Expect(events).ShouldNot(matcher)
+			Expect(matcher.FailureMessage(events)).Should(HaveSuffix("the sequence broke at element 2:\n    <string>: connect\nwhich was not found after index 4, where element 1 matched"))
+		})
+
+		It("should report a first element that was not found at all", func() {
+			matcher := ContainElementsInOrder("missing", "start")
+			Expect(events).ShouldNot(matcher)
+			Expect(matcher.FailureMessage(events)).Should(HaveSuffix("the sequence broke at element 0:\n    <string>: missing\nwhich was not found"))
+		})
+	})
+
+	Context("when passed matchers", func() {
+		It("should use them to match elements", func() {
+			Expect(events).Should(ContainElementsInOrder(HavePrefix("con"), "ready", HaveSuffix("op")))
+			Expect(events).ShouldNot(ContainElementsInOrder(HavePrefix("st"), HavePrefix("st"), HavePrefix("st")))
+		})
+	})
+
+	Context("with anything else", func() {
+		It("should error", func() {
+			success, err := ContainElementsInOrder("a").Match(map[int]string{1: "a"})
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(HaveOccurred())
+
+			success, err = ContainElementsInOrder("a").Match("abc")
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
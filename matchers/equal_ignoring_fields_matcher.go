@@ -0,0 +1,131 @@
+package matchers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/onsi/gomega/format"
+)
+
+type EqualIgnoringFieldsMatcher struct {
+	Expected interface{}
+	Fields   []string
+
+	maskedActual   interface{}
+	maskedExpected interface{}
+}
+
+func (matcher *EqualIgnoringFieldsMatcher) Match(actual interface{}) (success bool, err error) {
+	if actual == nil && matcher.Expected == nil {
+		return false, fmt.Errorf("Refusing to compare <nil> to <nil>.\nBe explicit and use BeNil() instead.  This is to avoid mistakes where both sides of an assertion are erroneously uninitialized.")
+	}
+	if actual == nil || matcher.Expected == nil || reflect.TypeOf(actual) != reflect.TypeOf(matcher.Expected) {
+		return false, fmt.Errorf("EqualIgnoringFields expects actual and expected to have the same type.  Got:\n%s\nand:\n%s", format.Object(actual, 1), format.Object(matcher.Expected, 1))
+	}
+
+	matcher.maskedActual, err = maskFields(actual, matcher.Fields)
+	if err != nil {
+		return false, err
+	}
+	matcher.maskedExpected, err = maskFields(matcher.Expected, matcher.Fields)
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(matcher.maskedActual, matcher.maskedExpected), nil
+}
+
+func (matcher *EqualIgnoringFieldsMatcher) FailureMessage(actual interface{}) (message string) {
+	message = format.Message(matcher.maskedActual, fmt.Sprintf("to equal, ignoring fields %s,", strings.Join(matcher.Fields, ", ")), matcher.maskedExpected)
+	diffs := differingFields(reflect.ValueOf(matcher.maskedActual), reflect.ValueOf(matcher.maskedExpected), "")
+	if len(diffs) > 0 {
+		message += "\nThe following fields differ:\n" + format.IndentString(strings.Join(diffs, "\n"), 1)
+	}
+	return message
+}
+
+func (matcher *EqualIgnoringFieldsMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	return format.Message(matcher.maskedActual, fmt.Sprintf("not to equal, ignoring fields %s,", strings.Join(matcher.Fields, ", ")), matcher.maskedExpected)
+}
+
+// maskFields returns a copy of value with each of the dotted field paths set to its zero value.
+// Pointers along a path are copied rather than written through, so value itself is never modified.
+func maskFields(value interface{}, fields []string) (interface{}, error) {
+	masked := reflect.New(reflect.TypeOf(value)).Elem()
+	masked.Set(reflect.ValueOf(value))
+
+	for _, field := range fields {
+		if err := maskField(masked, strings.Split(field, "."), field); err != nil {
+			return nil, err
+		}
+	}
+
+	return masked.Interface(), nil
+}
+
+func maskField(v reflect.Value, path []string, field string) error {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		pointee := reflect.New(v.Type().Elem())
+		pointee.Elem().Set(v.Elem())
+		v.Set(pointee)
+		v = pointee.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("EqualIgnoringFields cannot ignore field %q: %s is not a struct", field, v.Type())
+	}
+
+	structField, ok := v.Type().FieldByName(path[0])
+	if !ok {
+		return fmt.Errorf("EqualIgnoringFields cannot ignore field %q: %s has no field named %s", field, v.Type(), path[0])
+	}
+	if structField.PkgPath != "" {
+		return fmt.Errorf("EqualIgnoringFields cannot ignore field %q: %s.%s is unexported", field, v.Type(), path[0])
+	}
+
+	fieldValue := v.FieldByIndex(structField.Index)
+	if len(path) == 1 {
+		fieldValue.Set(reflect.Zero(fieldValue.Type()))
+		return nil
+	}
+	return maskField(fieldValue, path[1:], field)
+}
+
+// differingFields lists the dotted paths of the leaf fields that differ between two values of the same type.
+// Unexported fields cannot be named, so a nested struct that differs only in its unexported fields (a time.Time, say)
+// is reported as a whole.
+func differingFields(a reflect.Value, b reflect.Value, prefix string) []string {
+	if a.Kind() == reflect.Ptr && b.Kind() == reflect.Ptr && !a.IsNil() && !b.IsNil() {
+		return differingFields(a.Elem(), b.Elem(), prefix)
+	}
+
+	if a.Kind() != reflect.Struct {
+		if prefix != "" && !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			return []string{prefix}
+		}
+		return nil
+	}
+
+	diffs := []string{}
+	hasUnexportedFields := false
+	for i := 0; i < a.NumField(); i++ {
+		if a.Type().Field(i).PkgPath != "" {
+			hasUnexportedFields = true
+			continue
+		}
+		name := a.Type().Field(i).Name
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+		diffs = append(diffs, differingFields(a.Field(i), b.Field(i), name)...)
+	}
+
+	if len(diffs) == 0 && hasUnexportedFields && prefix != "" && !reflect.DeepEqual(a.Interface(), b.Interface()) {
+		return []string{prefix}
+	}
+	return diffs
+}
@@ -0,0 +1,98 @@
+package matchers_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/matchers"
+)
+
+type ignoredMetadata struct {
+	Owner     string
+	CreatedAt time.Time
+}
+
+type ignoredRecord struct {
+	ID       int
+	Name     string
+	Metadata ignoredMetadata
+	Parent   *ignoredRecord
+	secret   string
+}
+
+var _ = Describe("EqualIgnoringFields", func() {
+	var actual, expected ignoredRecord
+
+	BeforeEach(func() {
+		actual = ignoredRecord{ID: 1, Name: "a", Metadata: ignoredMetadata{Owner: "me", CreatedAt: time.Unix(100, 0)}}
+		expected = ignoredRecord{ID: 2, Name: "a", Metadata: ignoredMetadata{Owner: "me", CreatedAt: time.Unix(200, 0)}}
+	})
+
+	It("should ignore a top-level field and a nested field", func() {
+		Expect(actual).ShouldNot(EqualIgnoringFields(expected))
+		Expect(actual).ShouldNot(EqualIgnoringFields(expected, "ID"))
+		Expect(actual).ShouldNot(EqualIgnoringFields(expected, "Metadata.CreatedAt"))
+		Expect(actual).Should(EqualIgnoringFields(expected, "ID", "Metadata.CreatedAt"))
+
+		expected.Metadata.Owner = "you"
+		Expect(actual).ShouldNot(EqualIgnoringFields(expected, "ID", "Metadata.CreatedAt"))
+	})
+
+	It("should follow pointers without modifying either side", func() {
+		actual.Parent = &ignoredRecord{ID: 10, Name: "p"}
+		expected.Parent = &ignoredRecord{ID: 20, Name: "p"}
+
+		Expect(&actual).Should(EqualIgnoringFields(&expected, "ID", "Metadata.CreatedAt", "Parent.ID"))
+		Expect(actual.ID).Should(Equal(1))
+		Expect(actual.Parent.ID).Should(Equal(10))
+		Expect(expected.Parent.ID).Should(Equal(20))
+	})
+
+	It("should report the differing fields of the masked values", func() {
+		matcher := EqualIgnoringFields(expected, "Metadata.CreatedAt")
+		success, err := matcher.Match(actual)
+		Expect(success).Should(BeFalse())
+		Expect(err).ShouldNot(HaveOccurred())
+
+		message := matcher.FailureMessage(actual)
+		Expect(message).Should(ContainSubstring("to equal, ignoring fields Metadata.CreatedAt,"))
+		Expect(message).Should(ContainSubstring("The following fields differ:\n    ID"))
+		Expect(message).ShouldNot(ContainSubstring("    Metadata.CreatedAt"))
+	})
+
+	It("should report a differing time.Time field", func() {
+		matcher := EqualIgnoringFields(expected, "ID")
+		Expect(matcher.Match(actual)).Should(BeFalse())
+
+		message := matcher.FailureMessage(actual)
+		Expect(message).Should(HaveSuffix("The following fields differ:\n    Metadata.CreatedAt"))
+	})
+
+	Context("when passed an unknown or unexported field", func() {
+		It("should error", func() {
+			success, err := (&EqualIgnoringFieldsMatcher{Expected: expected, Fields: []string{"Nope"}}).Match(actual)
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(MatchError(ContainSubstring(`cannot ignore field "Nope": matchers_test.ignoredRecord has no field named Nope`)))
+
+			_, err = (&EqualIgnoringFieldsMatcher{Expected: expected, Fields: []string{"Metadata.Nope"}}).Match(actual)
+			Expect(err).Should(MatchError(ContainSubstring("has no field named Nope")))
+
+			_, err = (&EqualIgnoringFieldsMatcher{Expected: expected, Fields: []string{"Name.Length"}}).Match(actual)
+			Expect(err).Should(MatchError(ContainSubstring("string is not a struct")))
+
+			_, err = (&EqualIgnoringFieldsMatcher{Expected: expected, Fields: []string{"secret"}}).Match(actual)
+			Expect(err).Should(MatchError(ContainSubstring("is unexported")))
+		})
+	})
+
+	Context("when actual and expected have different types or are both nil", func() {
+		It("should error", func() {
+			_, err := (&EqualIgnoringFieldsMatcher{Expected: expected}).Match(&actual)
+			Expect(err).Should(HaveOccurred())
+
+			_, err = (&EqualIgnoringFieldsMatcher{Expected: nil}).Match(nil)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -7,7 +7,8 @@ import (
 )
 
 type HaveLenMatcher struct {
-	Count int
+	//Count is either an int or a matcher that is applied to the length
+	Count interface{}
 }
 
 func (matcher *HaveLenMatcher) Match(actual interface{}) (success bool, err error) {
@@ -16,13 +17,29 @@ func (matcher *HaveLenMatcher) Match(actual interface{}) (success bool, err erro
 		return false, fmt.Errorf("HaveLen matcher expects a string/array/map/channel/slice.  Got:\n%s", format.Object(actual, 1))
 	}
 
-	return length == matcher.Count, nil
+	if lengthMatcher, ok := matcher.Count.(omegaMatcher); ok {
+		return lengthMatcher.Match(length)
+	}
+
+	if !isInteger(matcher.Count) && !isUnsignedInteger(matcher.Count) {
+		return false, fmt.Errorf("HaveLen matcher expects an integer or a matcher for the length.  Got:\n%s", format.Object(matcher.Count, 1))
+	}
+
+	return int64(length) == toInteger(matcher.Count), nil
 }
 
 func (matcher *HaveLenMatcher) FailureMessage(actual interface{}) (message string) {
+	if lengthMatcher, ok := matcher.Count.(omegaMatcher); ok {
+		length, _ := lengthOf(actual)
+		return fmt.Sprintf("Expected\n%s\nto have a length that matches.  Its length is %d:\n%s", format.Object(actual, 1), length, format.IndentString(lengthMatcher.FailureMessage(length), 1))
+	}
 	return fmt.Sprintf("Expected\n%s\nto have length %d", format.Object(actual, 1), matcher.Count)
 }
 
 func (matcher *HaveLenMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	if lengthMatcher, ok := matcher.Count.(omegaMatcher); ok {
+		length, _ := lengthOf(actual)
+		return fmt.Sprintf("Expected\n%s\nnot to have a length that matches.  Its length is %d:\n%s", format.Object(actual, 1), length, format.IndentString(lengthMatcher.NegatedFailureMessage(length), 1))
+	}
 	return fmt.Sprintf("Expected\n%s\nnot to have length %d", format.Object(actual, 1), matcher.Count)
 }
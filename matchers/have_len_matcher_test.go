@@ -29,6 +29,51 @@ var _ = Describe("HaveLen", func() {
 		})
 	})
 
+	When("passed a matcher for the length", func() {
+		It("should apply it to the length", func() {
+			Expect("AAA").Should(HaveLen(BeNumerically(">=", 3)))
+			Expect("AA").ShouldNot(HaveLen(BeNumerically(">=", 3)))
+
+			Expect([]int{1, 2, 3}).Should(HaveLen(BeNumerically(">", 2)))
+			Expect([]int{}).Should(HaveLen(BeZero()))
+
+			Expect(map[string]int{"a": 1, "b": 2}).Should(HaveLen(BeNumerically("<", 3)))
+			Expect(map[string]int{"a": 1, "b": 2}).ShouldNot(HaveLen(Equal(1)))
+
+			c := make(chan bool, 3)
+			c <- true
+			Expect(c).Should(HaveLen(BeNumerically("~", 2, 1)))
+			Expect(c).ShouldNot(HaveLen(BeNumerically(">", 1)))
+		})
+
+		It("should show the actual length and the constraint on failure", func() {
+			matcher := HaveLen(BeNumerically(">=", 3))
+			Expect(matcher.FailureMessage([]int{1, 2})).Should(Equal("Expected\n    <[]int | len:2, cap:2>: [1, 2]\nto have a length that matches.  Its length is 2:\n    Expected\n        <int>: 2\n    to be >=\n        <int>: 3"))
+			Expect(matcher.NegatedFailureMessage([]int{1, 2, 3})).Should(ContainSubstring("not to have a length that matches.  Its length is 3:"))
+		})
+
+		It("should pass along errors from the matcher", func() {
+			success, err := HaveLen(ContainSubstring("a")).Match("abc")
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	When("passed an int", func() {
+		It("should keep its failure message", func() {
+			Expect(HaveLen(3).FailureMessage([]int{1, 2})).Should(Equal("Expected\n    <[]int | len:2, cap:2>: [1, 2]\nto have length 3"))
+			Expect(HaveLen(uint8(2)).Match([]int{1, 2})).Should(BeTrue())
+		})
+	})
+
+	When("passed something other than an integer or a matcher", func() {
+		It("should error", func() {
+			success, err := HaveLen("3").Match([]int{1, 2, 3})
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(MatchError(ContainSubstring("expects an integer or a matcher")))
+		})
+	})
+
 	When("passed a correctly typed nil", func() {
 		It("should operate succesfully on the passed in value", func() {
 			var nilSlice []int
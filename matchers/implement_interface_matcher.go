@@ -0,0 +1,60 @@
+package matchers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/onsi/gomega/format"
+)
+
+type ImplementInterfaceMatcher struct {
+	Interface interface{}
+}
+
+func (matcher *ImplementInterfaceMatcher) Match(actual interface{}) (success bool, err error) {
+	interfaceType, err := matcher.interfaceType()
+	if err != nil {
+		return false, err
+	}
+	if actual == nil {
+		return false, nil
+	}
+
+	return reflect.TypeOf(actual).Implements(interfaceType), nil
+}
+
+func (matcher *ImplementInterfaceMatcher) interfaceType() (reflect.Type, error) {
+	t := reflect.TypeOf(matcher.Interface)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Interface {
+		return nil, fmt.Errorf("ImplementInterface must be passed a nil pointer to an interface type, e.g. (*io.Reader)(nil).  Got:\n%s", format.Object(matcher.Interface, 1))
+	}
+	return t.Elem(), nil
+}
+
+func (matcher *ImplementInterfaceMatcher) FailureMessage(actual interface{}) (message string) {
+	interfaceType, _ := matcher.interfaceType()
+	message = format.Message(actual, fmt.Sprintf("to implement the interface %s", interfaceType))
+	if actual == nil {
+		return message + "\nbut nil has no dynamic type, so it implements no interface"
+	}
+
+	actualType := reflect.TypeOf(actual)
+	missing := []string{}
+	for i := 0; i < interfaceType.NumMethod(); i++ {
+		method := interfaceType.Method(i)
+		if _, ok := actualType.MethodByName(method.Name); !ok {
+			missing = append(missing, method.Name)
+		}
+	}
+	message += fmt.Sprintf("\n%s is missing the method(s): %s", actualType, strings.Join(missing, ", "))
+	if actualType.Kind() != reflect.Ptr && reflect.PtrTo(actualType).Implements(interfaceType) {
+		message += fmt.Sprintf("\nNote that %s does implement it - some methods have pointer receivers", reflect.PtrTo(actualType))
+	}
+	return message
+}
+
+func (matcher *ImplementInterfaceMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	interfaceType, _ := matcher.interfaceType()
+	return format.Message(actual, fmt.Sprintf("not to implement the interface %s", interfaceType))
+}
@@ -0,0 +1,67 @@
+package matchers_test
+
+import (
+	"fmt"
+	"io"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/matchers"
+)
+
+type valueStringer struct{}
+
+func (valueStringer) String() string { return "value" }
+
+type pointerReadCloser struct{}
+
+func (*pointerReadCloser) Read(p []byte) (int, error) { return 0, io.EOF }
+func (*pointerReadCloser) Close() error               { return nil }
+
+var _ = Describe("ImplementInterface", func() {
+	It("should succeed when the actual's dynamic type implements the interface", func() {
+		Expect(valueStringer{}).Should(ImplementInterface((*fmt.Stringer)(nil)))
+		Expect(&valueStringer{}).Should(ImplementInterface((*fmt.Stringer)(nil)))
+		Expect(&pointerReadCloser{}).Should(ImplementInterface((*io.ReadCloser)(nil)))
+
+		var r io.Reader = &pointerReadCloser{}
+		Expect(r).Should(ImplementInterface((*io.Closer)(nil)))
+	})
+
+	It("should fail, listing the missing methods, when it does not", func() {
+		matcher := ImplementInterface((*io.ReadCloser)(nil))
+		Expect(matcher.Match(valueStringer{})).Should(BeFalse())
+
+		message := matcher.FailureMessage(valueStringer{})
+		Expect(message).Should(ContainSubstring("to implement the interface io.ReadCloser"))
+		Expect(message).Should(ContainSubstring("matchers_test.valueStringer is missing the method(s): Close, Read"))
+		Expect(message).ShouldNot(ContainSubstring("pointer receivers"))
+	})
+
+	It("should point out pointer-receiver-only implementations", func() {
+		matcher := ImplementInterface((*io.ReadCloser)(nil))
+		Expect(matcher.Match(pointerReadCloser{})).Should(BeFalse())
+		Expect(matcher.FailureMessage(pointerReadCloser{})).Should(ContainSubstring("Note that *matchers_test.pointerReadCloser does implement it - some methods have pointer receivers"))
+	})
+
+	It("should fail clearly for a nil actual", func() {
+		matcher := ImplementInterface((*fmt.Stringer)(nil))
+		success, err := matcher.Match(nil)
+		Expect(success).Should(BeFalse())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(matcher.FailureMessage(nil)).Should(ContainSubstring("nil has no dynamic type, so it implements no interface"))
+	})
+
+	Context("when not passed a nil pointer to an interface", func() {
+		It("should error", func() {
+			_, err := (&ImplementInterfaceMatcher{Interface: valueStringer{}}).Match(valueStringer{})
+			Expect(err).Should(HaveOccurred())
+
+			_, err = (&ImplementInterfaceMatcher{Interface: nil}).Match(valueStringer{})
+			Expect(err).Should(HaveOccurred())
+
+			_, err = (&ImplementInterfaceMatcher{Interface: &valueStringer{}}).Match(valueStringer{})
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
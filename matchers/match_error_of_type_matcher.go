@@ -0,0 +1,63 @@
+package matchers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/onsi/gomega/format"
+	"golang.org/x/xerrors"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+type MatchErrorOfTypeMatcher struct {
+	Target interface{}
+}
+
+func (matcher *MatchErrorOfTypeMatcher) Match(actual interface{}) (success bool, err error) {
+	if isNil(actual) {
+		return false, fmt.Errorf("Expected an error, got nil")
+	}
+
+	if !isError(actual) {
+		return false, fmt.Errorf("Expected an error.  Got:\n%s", format.Object(actual, 1))
+	}
+
+	targetValue := reflect.ValueOf(matcher.Target)
+	if matcher.Target == nil || targetValue.Kind() != reflect.Ptr || targetValue.IsNil() {
+		return false, fmt.Errorf("MatchErrorOfType must be passed a non-nil pointer to an error type.  Got:\n%s", format.Object(matcher.Target, 1))
+	}
+	if targetType := targetValue.Type().Elem(); targetType.Kind() != reflect.Interface && !targetType.Implements(errorType) {
+		return false, fmt.Errorf("MatchErrorOfType must be passed a pointer to a type implementing error.  %s does not.", targetType)
+	}
+
+	return xerrors.As(actual.(error), matcher.Target), nil
+}
+
+func (matcher *MatchErrorOfTypeMatcher) FailureMessage(actual interface{}) (message string) {
+	return fmt.Sprintf("Expected error chain\n%s\nto contain an error of type\n%s%s", errorChain(actual), format.Indent, matcher.targetType())
+}
+
+func (matcher *MatchErrorOfTypeMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	return fmt.Sprintf("Expected error chain\n%s\nnot to contain an error of type\n%s%s", errorChain(actual), format.Indent, matcher.targetType())
+}
+
+func (matcher *MatchErrorOfTypeMatcher) targetType() string {
+	return fmt.Sprintf("<%s>", reflect.TypeOf(matcher.Target).Elem())
+}
+
+// errorChain renders each error in actual's chain, outermost first, on its own line.
+func errorChain(actual interface{}) string {
+	err, ok := actual.(error)
+	if !ok {
+		return format.Object(actual, 1)
+	}
+
+	lines := []string{}
+	for i := 0; err != nil; i++ {
+		lines = append(lines, fmt.Sprintf("%s[%d] <%T>: %s", format.Indent, i, err, err.Error()))
+		err = xerrors.Unwrap(err)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,86 @@
+package matchers_test
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type codedError struct {
+	Code int
+}
+
+func (e *codedError) Error() string {
+	return fmt.Sprintf("failed with code %d", e.Code)
+}
+
+type temporary interface {
+	Temporary() bool
+}
+
+var _ = Describe("MatchErrorOfType", func() {
+	Context("when the chain contains an error of the target type", func() {
+		It("should succeed and bind the error", func() {
+			err := fmt.Errorf("outer: %w", fmt.Errorf("middle: %w", &codedError{Code: 42}))
+
+			var target *codedError
+			Expect(err).Should(MatchErrorOfType(&target))
+			Expect(target.Code).Should(Equal(42))
+		})
+
+		It("should work with errors from the standard library", func() {
+			err := fmt.Errorf("connecting: %w", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")})
+
+			var opErr *net.OpError
+			Expect(err).Should(MatchErrorOfType(&opErr))
+			Expect(opErr.Op).Should(Equal("dial"))
+		})
+
+		It("should support interface targets", func() {
+			err := fmt.Errorf("connecting: %w", &net.DNSError{Err: "no such host", IsTemporary: true})
+
+			var target temporary
+			Expect(err).Should(MatchErrorOfType(&target))
+			Expect(target.Temporary()).Should(BeTrue())
+		})
+	})
+
+	Context("when the chain does not contain an error of the target type", func() {
+		It("should fail and print the chain", func() {
+			err := fmt.Errorf("outer: %w", errors.New("inner"))
+
+			var target *codedError
+			matcher := MatchErrorOfType(&target)
+			Expect(err).ShouldNot(matcher)
+			Expect(target).Should(BeNil())
+
+			Expect(matcher.FailureMessage(err)).Should(Equal("Expected error chain\n" +
+				"    [0] <*fmt.wrapError>: outer: inner\n" +
+				"    [1] <*errors.errorString>: inner\n" +
+				"to contain an error of type\n" +
+				"    <*matchers_test.codedError>"))
+		})
+	})
+
+	Context("when passed invalid input", func() {
+		It("should error", func() {
+			var target *codedError
+
+			_, err := MatchErrorOfType(&target).Match(nil)
+			Expect(err).Should(MatchError("Expected an error, got nil"))
+
+			_, err = MatchErrorOfType(&target).Match("not an error")
+			Expect(err).Should(HaveOccurred())
+
+			_, err = MatchErrorOfType(target).Match(errors.New("boom"))
+			Expect(err).Should(MatchError(ContainSubstring("non-nil pointer")))
+
+			var notAnError string
+			_, err = MatchErrorOfType(&notAnError).Match(errors.New("boom"))
+			Expect(err).Should(MatchError(ContainSubstring("string does not")))
+		})
+	})
+})
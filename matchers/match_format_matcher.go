@@ -0,0 +1,143 @@
+package matchers
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"sync"
+
+	"github.com/onsi/gomega/format"
+)
+
+//FormatValidator checks that a string conforms to a format, returning an error explaining why it does not.
+type FormatValidator func(string) error
+
+var formatsLock = &sync.RWMutex{}
+var formats = map[string]FormatValidator{
+	"uuid":  validateUUID,
+	"url":   validateURL,
+	"email": validateEmail,
+	"ip":    validateIP,
+	"ipv4":  validateIPv4,
+	"ipv6":  validateIPv6,
+}
+
+//RegisterFormatValidator makes a validator available to MatchFormat under the passed-in name.
+//Registering an existing name replaces the previous validator.
+func RegisterFormatValidator(name string, validator FormatValidator) {
+	formatsLock.Lock()
+	defer formatsLock.Unlock()
+	formats[name] = validator
+}
+
+func lookupFormat(name string) (FormatValidator, bool) {
+	formatsLock.RLock()
+	defer formatsLock.RUnlock()
+	validator, ok := formats[name]
+	return validator, ok
+}
+
+type MatchFormatMatcher struct {
+	Format string
+}
+
+func (matcher *MatchFormatMatcher) Match(actual interface{}) (success bool, err error) {
+	actualString, ok := toString(actual)
+	if !ok {
+		return false, fmt.Errorf("MatchFormat matcher requires a string or stringer.  Got:\n%s", format.Object(actual, 1))
+	}
+	validator, ok := lookupFormat(matcher.Format)
+	if !ok {
+		return false, fmt.Errorf("MatchFormat matcher does not know the format %q.  Use RegisterFormat to add it.", matcher.Format)
+	}
+	return validator(actualString) == nil, nil
+}
+
+func (matcher *MatchFormatMatcher) FailureMessage(actual interface{}) (message string) {
+	reason := "it does not"
+	actualString, _ := toString(actual)
+	if validator, ok := lookupFormat(matcher.Format); ok {
+		if err := validator(actualString); err != nil {
+			reason = err.Error()
+		}
+	}
+	return format.Message(actual, fmt.Sprintf("to be a valid %s, but %s", matcher.Format, reason))
+}
+
+func (matcher *MatchFormatMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	return format.Message(actual, fmt.Sprintf("not to be a valid %s", matcher.Format))
+}
+
+func validateUUID(s string) error {
+	if len(s) != 36 {
+		return fmt.Errorf("expected 36 characters, got %d", len(s))
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return fmt.Errorf("expected '-' at position %d, got %q", i, c)
+			}
+		default:
+			if !isHexDigit(c) {
+				return fmt.Errorf("invalid hex character %q at position %d", c, i)
+			}
+		}
+	}
+	return nil
+}
+
+func isHexDigit(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
+
+func validateURL(s string) error {
+	u, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+	if u.Scheme == "" {
+		return errors.New("missing scheme")
+	}
+	if u.Opaque == "" && u.Host == "" && u.Scheme != "file" {
+		return errors.New("missing host")
+	}
+	return nil
+}
+
+func validateEmail(s string) error {
+	address, err := mail.ParseAddress(s)
+	if err != nil {
+		return err
+	}
+	if address.Address != s {
+		return fmt.Errorf("expected a bare address, got %q with a display name", s)
+	}
+	return nil
+}
+
+func validateIP(s string) error {
+	if net.ParseIP(s) == nil {
+		return errors.New("not an IP address")
+	}
+	return nil
+}
+
+func validateIPv4(s string) error {
+	ip := net.ParseIP(s)
+	if ip == nil || ip.To4() == nil {
+		return errors.New("not an IPv4 address")
+	}
+	return nil
+}
+
+func validateIPv6(s string) error {
+	ip := net.ParseIP(s)
+	if ip == nil || ip.To4() != nil {
+		return errors.New("not an IPv6 address")
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package matchers_test
+
+import (
+	"errors"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/matchers"
+)
+
+var _ = Describe("MatchFormat", func() {
+	Describe("BeValidUUID", func() {
+		It("should match UUIDs", func() {
+			Expect("6ba7b810-9dad-11d1-80b4-00c04fd430c8").Should(BeValidUUID())
+			Expect("6BA7B810-9DAD-11D1-80B4-00C04FD430C8").Should(BeValidUUID())
+			Expect([]byte("00000000-0000-0000-0000-000000000000")).Should(BeValidUUID())
+		})
+
+		It("should explain why a value is not a UUID", func() {
+			Expect("6ba7b810").ShouldNot(BeValidUUID())
+			Expect(BeValidUUID().FailureMessage("6ba7b810")).Should(HaveSuffix("to be a valid uuid, but expected 36 characters, got 8"))
+
+			Expect("6ba7b810-9dad-11d1-80b4_00c04fd430c8").ShouldNot(BeValidUUID())
+			Expect(BeValidUUID().FailureMessage("6ba7b810-9dad-11d1-80b4_00c04fd430c8")).Should(HaveSuffix("but expected '-' at position 23, got '_'"))
+
+			Expect("6ba7b810-9dad-11d1-80b4-00c04fd430cg").ShouldNot(BeValidUUID())
+			Expect(BeValidUUID().FailureMessage("6ba7b810-9dad-11d1-80b4-00c04fd430cg")).Should(HaveSuffix("but invalid hex character 'g' at position 35"))
+		})
+	})
+
+	Describe("BeValidURL", func() {
+		It("should match URLs", func() {
+			Expect("https://example.com/path?q=1").Should(BeValidURL())
+			Expect("http://127.0.0.1:8080").Should(BeValidURL())
+			Expect("file:///tmp/foo").Should(BeValidURL())
+			Expect("mailto:someone@example.com").Should(BeValidURL())
+		})
+
+		It("should explain why a value is not a URL", func() {
+			Expect("example.com/path").ShouldNot(BeValidURL())
+			Expect(BeValidURL().FailureMessage("example.com/path")).Should(HaveSuffix("to be a valid url, but missing scheme"))
+
+			Expect("http://").ShouldNot(BeValidURL())
+			Expect(BeValidURL().FailureMessage("http://")).Should(HaveSuffix("but missing host"))
+
+			Expect("http://exa mple.com").ShouldNot(BeValidURL())
+			Expect(BeValidURL().FailureMessage("http://exa mple.com")).Should(ContainSubstring("invalid character"))
+		})
+	})
+
+	Describe("the email format", func() {
+		It("should match email addresses", func() {
+			Expect("someone@example.com").Should(MatchFormat("email"))
+			Expect("first.last+tag@sub.example.org").Should(MatchFormat("email"))
+		})
+
+		It("should reject anything else", func() {
+			Expect("someone").ShouldNot(MatchFormat("email"))
+			Expect("Some One <someone@example.com>").ShouldNot(MatchFormat("email"))
+			Expect(MatchFormat("email").FailureMessage("Some One <someone@example.com>")).Should(ContainSubstring("display name"))
+		})
+	})
+
+	Describe("the ip formats", func() {
+		It("should match IP addresses", func() {
+			Expect("10.0.0.1").Should(MatchFormat("ip"))
+			Expect("::1").Should(MatchFormat("ip"))
+			Expect("10.0.0.1").Should(MatchFormat("ipv4"))
+			Expect("fe80::1").Should(MatchFormat("ipv6"))
+		})
+
+		It("should reject anything else", func() {
+			Expect("10.0.0.256").ShouldNot(MatchFormat("ip"))
+			Expect("::1").ShouldNot(MatchFormat("ipv4"))
+			Expect("10.0.0.1").ShouldNot(MatchFormat("ipv6"))
+			Expect(MatchFormat("ipv6").FailureMessage("10.0.0.1")).Should(HaveSuffix("to be a valid ipv6, but not an IPv6 address"))
+		})
+	})
+
+	Describe("registering a custom format", func() {
+		It("should make the format available to MatchFormat", func() {
+			RegisterFormat("shouting", func(s string) error {
+				if strings.ToUpper(s) != s {
+					return errors.New("it contains lowercase letters")
+				}
+				return nil
+			})
+
+			Expect("HELLO").Should(MatchFormat("shouting"))
+			Expect("Hello").ShouldNot(MatchFormat("shouting"))
+			Expect(MatchFormat("shouting").FailureMessage("Hello")).Should(HaveSuffix("to be a valid shouting, but it contains lowercase letters"))
+			Expect(MatchFormat("shouting").NegatedFailureMessage("HELLO")).Should(HaveSuffix("not to be a valid shouting"))
+		})
+	})
+
+	When("the format is unknown", func() {
+		It("should error", func() {
+			success, err := (&MatchFormatMatcher{Format: "nope"}).Match("foo")
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(MatchError(ContainSubstring(`does not know the format "nope"`)))
+		})
+	})
+
+	When("actual is not a string", func() {
+		It("should error", func() {
+			success, err := (&MatchFormatMatcher{Format: "uuid"}).Match(3)
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
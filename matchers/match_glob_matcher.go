@@ -0,0 +1,34 @@
+package matchers
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/onsi/gomega/format"
+)
+
+type MatchGlobMatcher struct {
+	Pattern string
+}
+
+func (matcher *MatchGlobMatcher) Match(actual interface{}) (success bool, err error) {
+	actualString, ok := toString(actual)
+	if !ok {
+		return false, fmt.Errorf("MatchGlob matcher requires a string or stringer.  Got:\n%s", format.Object(actual, 1))
+	}
+
+	matched, err := path.Match(matcher.Pattern, actualString)
+	if err != nil {
+		return false, fmt.Errorf("MatchGlob was passed an invalid glob pattern %q: %s", matcher.Pattern, err)
+	}
+
+	return matched, nil
+}
+
+func (matcher *MatchGlobMatcher) FailureMessage(actual interface{}) (message string) {
+	return format.Message(actual, "to match glob pattern", matcher.Pattern)
+}
+
+func (matcher *MatchGlobMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	return format.Message(actual, "not to match glob pattern", matcher.Pattern)
+}
@@ -0,0 +1,47 @@
+package matchers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/matchers"
+)
+
+var _ = Describe("MatchGlob", func() {
+	It("should match the whole string against the glob", func() {
+		Expect("build-1.2.3.tar.gz").Should(MatchGlob("build-*.tar.gz"))
+		Expect("build-1.2.3.tar.gz").Should(MatchGlob("build-?.?.?.tar.[gx]z"))
+		Expect("build-1.2.3.tar.gz").Should(MatchGlob(`build-[^a-z].*`))
+		Expect("a*b").Should(MatchGlob(`a\*b`))
+		Expect(&myStringer{a: "build-x.tar.gz"}).Should(MatchGlob("build-*.tar.gz"))
+	})
+
+	It("should fail when the string does not match", func() {
+		Expect("build-1.2.3.zip").ShouldNot(MatchGlob("build-*.tar.gz"))
+		Expect("prefix build-1.tar.gz").ShouldNot(MatchGlob("build-*.tar.gz"))
+		Expect("dir/build-1.tar.gz").ShouldNot(MatchGlob("*.tar.gz"))
+	})
+
+	It("should show the glob and actual in the failure message", func() {
+		message := MatchGlob("build-*.tar.gz").FailureMessage("build.zip")
+		Expect(message).Should(Equal("Expected\n    <string>: build.zip\nto match glob pattern\n    <string>: build-*.tar.gz"))
+	})
+
+	Context("when passed an invalid pattern", func() {
+		It("should panic at construction", func() {
+			Expect(func() { MatchGlob("build-[") }).Should(Panic())
+		})
+
+		It("should error when the matcher is used directly", func() {
+			success, err := (&MatchGlobMatcher{Pattern: "build-["}).Match("build-1")
+			Expect(success).Should(BeFalse())
+			Expect(err).Should(MatchError(ContainSubstring(`invalid glob pattern "build-["`)))
+		})
+	})
+
+	Context("when actual is not a string", func() {
+		It("should error", func() {
+			_, err := (&MatchGlobMatcher{Pattern: "*"}).Match(3)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -5,14 +5,23 @@ package matchers
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/onsi/gomega/format"
 )
 
 type ReceiveMatcher struct {
 	Arg           interface{}
+	Timeout       time.Duration
 	receivedValue reflect.Value
 	channelClosed bool
+	timedOut      bool
+}
+
+//WithTimeout makes the matcher block for up to timeout waiting for a value, instead of returning immediately.
+func (matcher *ReceiveMatcher) WithTimeout(timeout time.Duration) *ReceiveMatcher {
+	matcher.Timeout = timeout
+	return matcher
 }
 
 func (matcher *ReceiveMatcher) Match(actual interface{}) (success bool, err error) {
@@ -40,10 +49,16 @@ func (matcher *ReceiveMatcher) Match(actual interface{}) (success bool, err erro
 		}
 	}
 
-	winnerIndex, value, open := reflect.Select([]reflect.SelectCase{
+	cases := []reflect.SelectCase{
 		{Dir: reflect.SelectRecv, Chan: channelValue},
-		{Dir: reflect.SelectDefault},
-	})
+	}
+	if matcher.Timeout > 0 {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(time.After(matcher.Timeout))})
+	} else {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectDefault})
+	}
+
+	winnerIndex, value, open := reflect.Select(cases)
 
 	var closed bool
 	var didReceive bool
@@ -52,6 +67,7 @@ func (matcher *ReceiveMatcher) Match(actual interface{}) (success bool, err erro
 		didReceive = open
 	}
 	matcher.channelClosed = closed
+	matcher.timedOut = winnerIndex == 1 && matcher.Timeout > 0
 
 	if closed {
 		return false, nil
@@ -90,10 +106,7 @@ func (matcher *ReceiveMatcher) Match(actual interface{}) (success bool, err erro
 func (matcher *ReceiveMatcher) FailureMessage(actual interface{}) (message string) {
 	subMatcher, hasSubMatcher := (matcher.Arg).(omegaMatcher)
 
-	closedAddendum := ""
-	if matcher.channelClosed {
-		closedAddendum = " The channel is closed."
-	}
+	closedAddendum := matcher.addendum()
 
 	if hasSubMatcher {
 		if matcher.receivedValue.IsValid() {
@@ -107,10 +120,7 @@ func (matcher *ReceiveMatcher) FailureMessage(actual interface{}) (message strin
 func (matcher *ReceiveMatcher) NegatedFailureMessage(actual interface{}) (message string) {
 	subMatcher, hasSubMatcher := (matcher.Arg).(omegaMatcher)
 
-	closedAddendum := ""
-	if matcher.channelClosed {
-		closedAddendum = " The channel is closed."
-	}
+	closedAddendum := matcher.addendum()
 
 	if hasSubMatcher {
 		if matcher.receivedValue.IsValid() {
@@ -121,6 +131,16 @@ func (matcher *ReceiveMatcher) NegatedFailureMessage(actual interface{}) (messag
 	return format.Message(actual, "not to receive anything."+closedAddendum)
 }
 
+func (matcher *ReceiveMatcher) addendum() string {
+	if matcher.channelClosed {
+		return " The channel is closed."
+	}
+	if matcher.timedOut {
+		return fmt.Sprintf(" Nothing was received within %s.", matcher.Timeout)
+	}
+	return ""
+}
+
 func (matcher *ReceiveMatcher) MatchMayChangeInTheFuture(actual interface{}) bool {
 	if !isChan(actual) {
 		return false
@@ -279,6 +279,53 @@ var _ = Describe("ReceiveMatcher", func() {
 		})
 	})
 
+	Describe("WithTimeout", func() {
+		It("should succeed when a value arrives within the timeout", func() {
+			c := make(chan string)
+			go func() {
+				time.Sleep(20 * time.Millisecond)
+				c <- "hello"
+			}()
+
+			var s string
+			Expect(c).Should(Receive(&s).WithTimeout(time.Second))
+			Expect(s).Should(Equal("hello"))
+		})
+
+		It("should match the received value against a matcher", func() {
+			c := make(chan string, 1)
+			c <- "hello"
+			Expect(c).Should(Receive(Equal("hello")).WithTimeout(time.Second))
+			Expect(c).ShouldNot(Receive().WithTimeout(10 * time.Millisecond))
+		})
+
+		It("should fail, reporting the timeout, when nothing arrives", func() {
+			c := make(chan string)
+			matcher := Receive().WithTimeout(20 * time.Millisecond)
+
+			t := time.Now()
+			success, err := matcher.Match(c)
+			Expect(time.Since(t)).Should(BeNumerically(">=", 20*time.Millisecond))
+			Expect(success).Should(BeFalse())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(matcher.FailureMessage(c)).Should(ContainSubstring("to receive something. Nothing was received within 20ms."))
+		})
+
+		It("should fail immediately, reporting the closed channel, when the channel is closed", func() {
+			c := make(chan string)
+			close(c)
+			matcher := Receive().WithTimeout(time.Second)
+
+			t := time.Now()
+			success, err := matcher.Match(c)
+			Expect(time.Since(t)).Should(BeNumerically("<", 500*time.Millisecond))
+			Expect(success).Should(BeFalse())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(matcher.FailureMessage(c)).Should(ContainSubstring("to receive something. The channel is closed."))
+			Expect(matcher.MatchMayChangeInTheFuture(c)).Should(BeFalse())
+		})
+	})
+
 	Describe("Bailing early", func() {
 		It("should bail early when passed a closed channel", func() {
 			c := make(chan bool)
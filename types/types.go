@@ -1,5 +1,10 @@
 package types
 
+import (
+	"context"
+	"time"
+)
+
 type TWithHelper interface {
 	Helper()
 }
@@ -24,3 +29,13 @@ type GomegaMatcher interface {
 	FailureMessage(actual interface{}) (message string)
 	NegatedFailureMessage(actual interface{}) (message string)
 }
+
+//AsyncAssertion is returned by Eventually and Consistently.  See gomega.AsyncAssertion for details.
+type AsyncAssertion interface {
+	Should(matcher GomegaMatcher, optionalDescription ...interface{}) bool
+	ShouldNot(matcher GomegaMatcher, optionalDescription ...interface{}) bool
+
+	WithMinSamples(n int) AsyncAssertion
+	WithContext(ctx context.Context) AsyncAssertion
+	Within(budget time.Duration) AsyncAssertion
+}